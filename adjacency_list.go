@@ -1,27 +1,35 @@
 package graph
 
 func (g *Graph[T]) AddNodeAdjList(node T) {
+	g.unshare()
 	if _, exists := g.nodes[node]; exists {
 		return
 	}
+	g.recordAddNode(node)
 	g.nodes[node] = struct{}{}
 	g.adjList[node] = make(map[T]struct{})
 }
 
 func (g *Graph[T]) RemoveNodeAdjList(node T) {
+	g.unshare()
 	if _, exists := g.nodes[node]; !exists {
 		return
 	}
+	g.recordRemoveNode(node)
 	delete(g.nodes, node)
 	delete(g.adjList, node)
 	for key, _ := range g.nodes {
 		delete(g.adjList[key], node)
 	}
+	g.attrs.removeNode(node)
 }
 
 func (g *Graph[T]) AddEdgeAdjList(from T, to T) {
-	g.AddNode(from)
-	g.AddNode(to)
+	g.unshare()
+	defer g.log.group()()
+	g.AddNodeAdjList(from)
+	g.AddNodeAdjList(to)
+	g.recordAddEdge(from, to)
 	g.adjList[from][to] = struct{}{}
 	if g.graphType == Undirected {
 		g.adjList[to][from] = struct{}{}
@@ -29,10 +37,13 @@ func (g *Graph[T]) AddEdgeAdjList(from T, to T) {
 }
 
 func (g *Graph[T]) RemoveEdgeAdjList(from T, to T) {
+	g.unshare()
+	g.recordRemoveEdge(from, to)
 	delete(g.adjList[from], to)
 	if g.graphType == Undirected {
 		delete(g.adjList[to], from)
 	}
+	g.attrs.removeEdge(from, to, g.IsDirected())
 }
 
 func (g *Graph[T]) HasEdgeAdjList(from T, to T) bool {
@@ -230,28 +241,26 @@ func (g *Graph[T]) DFSIterativeAllPathFindingAdjList(source T, target T) [][]T {
 		visitingOrders = visitingOrders[:len(visitingOrders)-1]
 		currVisited := visiteds[len(visiteds)-1]
 		visiteds = visiteds[:len(visiteds)-1]
-		if _, ok := currVisited[curr]; !ok {
-			if curr == target {
-				temp := make([]T, len(currOrder))
-				copy(temp, currOrder)
-				orders = append(orders, temp)
-			} else {
-				for k := range g.adjList[curr] {
-					if _, done := currVisited[k]; !done {
-						stack = append(stack, k)
-						newVisited := map[T]struct{}{}
-						for k := range currVisited {
-							newVisited[k] = struct{}{}
-						}
+		if curr == target {
+			temp := make([]T, len(currOrder))
+			copy(temp, currOrder)
+			orders = append(orders, temp)
+		} else {
+			for k := range g.adjList[curr] {
+				if _, done := currVisited[k]; !done {
+					stack = append(stack, k)
+					newVisited := map[T]struct{}{}
+					for k := range currVisited {
 						newVisited[k] = struct{}{}
-						newOrder := make([]T, len(currOrder)+1)
-						copy(newOrder, currOrder)
-						newOrder[len(currOrder)] = k
-						visiteds = append(visiteds, newVisited)
-						visitingOrders = append(visitingOrders, newOrder)
 					}
-
+					newVisited[k] = struct{}{}
+					newOrder := make([]T, len(currOrder)+1)
+					copy(newOrder, currOrder)
+					newOrder[len(currOrder)] = k
+					visiteds = append(visiteds, newVisited)
+					visitingOrders = append(visitingOrders, newOrder)
 				}
+
 			}
 		}
 
@@ -271,11 +280,15 @@ func (g *Graph[T]) DFSIterativeAnyPathFindingAdjList(source T, target T) []T {
 		currVisited := visiteds[len(visiteds)-1]
 		visiteds = visiteds[:len(visiteds)-1]
 		currVisitingOrder := visitingOrders[len(visitingOrders)-1]
+		visitingOrders = visitingOrders[:len(visitingOrders)-1]
 
 		if curr == target {
 			return currVisitingOrder
 		} else {
 			for k := range g.adjList[curr] {
+				if _, done := currVisited[k]; done {
+					continue
+				}
 				stack = append(stack, k)
 				newVisitingOrder := make([]T, len(currVisitingOrder)+1)
 				copy(newVisitingOrder, currVisitingOrder)
@@ -323,6 +336,10 @@ func (g *Graph[T]) BFSShortestPathAdjList(source T, target T) []T {
 	return []T{}
 }
 
+// HasCycleDirectedAdjList reports whether following the edges of the
+// adjacency list leads back to a node.
+//
+// Deprecated: Use HasCycleDirected, which works for either representation.
 func (g *Graph[T]) HasCycleDirectedAdjList() bool {
 	visited := make(map[T]struct{})
 	recStack := make(map[T]struct{})
@@ -353,6 +370,13 @@ func (g *Graph[T]) HasCycleDirectedAdjList() bool {
 	return false
 }
 
+// HasCycleUndirectedAdjList reports whether a search of the adjacency list
+// reaches a visited node other than the one it came from. A self-loop counts
+// unless it is on the node a search starts from, and edge directions are
+// followed as stored.
+//
+// Deprecated: Use HasCycleUndirected, which ignores edge directions and
+// counts every self-loop.
 func (g *Graph[T]) HasCycleUndirectedAdjList() bool {
 	visited := make(map[T]struct{})
 	var dfs func(source T, parent T) bool
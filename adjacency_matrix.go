@@ -1,9 +1,11 @@
 package graph
 
 func (g *Graph[T]) AddNodeAdjMatrix(node T) {
+	g.unshare()
 	if _, exists := g.nodes[node]; exists {
 		return
 	}
+	g.recordAddNode(node)
 	g.nodes[node] = struct{}{}
 	g.indexToNodes = append(g.indexToNodes, node)
 	g.nodesToIndex[node] = len(g.indexToNodes) - 1
@@ -16,9 +18,11 @@ func (g *Graph[T]) AddNodeAdjMatrix(node T) {
 }
 
 func (g *Graph[T]) RemoveNodeAdjMatrix(node T) {
+	g.unshare()
 	if _, exists := g.nodes[node]; !exists {
 		return
 	}
+	g.recordRemoveNode(node)
 	length := len(g.indexToNodes)
 	delete(g.nodes, node)
 	index := g.nodesToIndex[node]
@@ -38,17 +42,24 @@ func (g *Graph[T]) RemoveNodeAdjMatrix(node T) {
 		}
 		g.adjMatrix[k] = g.adjMatrix[k][:length-1]
 	}
+	for i := index; i < length-1; i++ {
+		g.adjMatrix[i] = g.adjMatrix[i+1]
+	}
 	g.adjMatrix = g.adjMatrix[:length-1]
+	g.attrs.removeNode(node)
 
 }
 
 func (g *Graph[T]) AddEdgeAdjMatrix(from T, to T) {
+	g.unshare()
+	defer g.log.group()()
 	if _, ok := g.nodes[from]; !ok {
-		g.AddNode(from)
+		g.AddNodeAdjMatrix(from)
 	}
 	if _, ok := g.nodes[to]; !ok {
-		g.AddNode(to)
+		g.AddNodeAdjMatrix(to)
 	}
+	g.recordAddEdge(from, to)
 	g.adjMatrix[g.nodesToIndex[from]][g.nodesToIndex[to]] = true
 	if g.graphType == Undirected {
 		g.adjMatrix[g.nodesToIndex[to]][g.nodesToIndex[from]] = true
@@ -57,16 +68,19 @@ func (g *Graph[T]) AddEdgeAdjMatrix(from T, to T) {
 }
 
 func (g *Graph[T]) RemoveEdgeAdjMatrix(from T, to T) {
+	g.unshare()
 	if _, ok := g.nodes[from]; !ok {
 		return
 	}
 	if _, ok := g.nodes[to]; !ok {
 		return
 	}
+	g.recordRemoveEdge(from, to)
 	g.adjMatrix[g.nodesToIndex[from]][g.nodesToIndex[to]] = false
 	if g.graphType == Undirected {
 		g.adjMatrix[g.nodesToIndex[to]][g.nodesToIndex[from]] = false
 	}
+	g.attrs.removeEdge(from, to, g.IsDirected())
 }
 func (g *Graph[T]) HasEdgeAdjMatrix(from T, to T) bool {
 	if _, ok := g.nodes[from]; !ok {
@@ -115,7 +129,7 @@ func (g *Graph[T]) EdgesAdjMatrix() [][2]T {
 				if g.graphType == Directed {
 					edges = append(edges, [2]T{g.indexToNodes[i], g.indexToNodes[k]})
 				} else {
-					if i < k {
+					if i <= k {
 						edges = append(edges, [2]T{g.indexToNodes[i], g.indexToNodes[k]})
 					}
 				}
@@ -216,6 +230,7 @@ func (g *Graph[T]) DFSRecursiveAdjMatrix(start T) []T {
 			}
 		}
 	}
+	dfs(start)
 	return order
 }
 
@@ -310,6 +325,9 @@ func (g *Graph[T]) DFSIterativeAllPathFindingAdjMatrix(source T, target T) [][]T
 				order = append(order, curr)
 				for i := 0; i < len(g.indexToNodes); i++ {
 					nbr := g.indexToNodes[i]
+					if !g.HasEdge(curr, nbr) {
+						continue
+					}
 					if _, done := visited[nbr]; !done {
 						stack = append(stack, nbr)
 						clonedOrder := append([]T{}, order...)
@@ -355,6 +373,9 @@ func (g *Graph[T]) DFSIterativeAnyPathFindingAdjMatrix(source T, target T) []T {
 				order = append(order, curr)
 				for i := 0; i < len(g.indexToNodes); i++ {
 					nbr := g.indexToNodes[i]
+					if !g.HasEdge(curr, nbr) {
+						continue
+					}
 					if _, done := visited[nbr]; !done {
 						stack = append(stack, nbr)
 						clonedOrder := append([]T{}, order...)
@@ -380,27 +401,25 @@ func (g *Graph[T]) BFSShortestPathAdjMatrix(source T, target T) []T {
 	queue := []T{source}
 	visited := map[T]struct{}{}
 	parents := map[T]T{}
+	visited[source] = struct{}{}
 	for len(queue) > 0 {
 		curr := queue[0]
 		queue = queue[1:]
-		if _, ok := visited[curr]; !ok {
-			visited[curr] = struct{}{}
-			if curr == target {
-				path := []T{}
-				for node := target; ; node = parents[node] {
-					path = append(path, node)
-					if node == source {
-						return path
-					}
+		if curr == target {
+			path := []T{}
+			for node := target; ; node = parents[node] {
+				path = append([]T{node}, path...)
+				if node == source {
+					return path
 				}
-			} else {
-				for i := 0; i < len(g.indexToNodes); i++ {
-					if g.HasEdge(curr, g.indexToNodes[i]) {
-						if _, done := visited[g.indexToNodes[i]]; !done {
-							queue = append(queue, g.indexToNodes[i])
-							parents[g.indexToNodes[i]] = curr
-						}
-					}
+			}
+		}
+		for i := 0; i < len(g.indexToNodes); i++ {
+			if g.HasEdge(curr, g.indexToNodes[i]) {
+				if _, done := visited[g.indexToNodes[i]]; !done {
+					visited[g.indexToNodes[i]] = struct{}{}
+					queue = append(queue, g.indexToNodes[i])
+					parents[g.indexToNodes[i]] = curr
 				}
 			}
 		}
@@ -408,6 +427,10 @@ func (g *Graph[T]) BFSShortestPathAdjMatrix(source T, target T) []T {
 	return []T{}
 }
 
+// HasCycleDirectedAdjMatrix reports whether following the edges of the
+// adjacency matrix leads back to a node.
+//
+// Deprecated: Use HasCycleDirected, which works for either representation.
 func (g *Graph[T]) HasCycleDirectedAdjMatrix() bool {
 	for i := 0; i < len(g.indexToNodes); i++ {
 		curr := g.indexToNodes[i]
@@ -442,6 +465,12 @@ func (g *Graph[T]) HasCycleDirectedAdjMatrix() bool {
 
 }
 
+// HasCycleUndirectedAdjMatrix reports whether a search of the adjacency
+// matrix reaches a visited node other than the one it came from. It always
+// reports false for a directed graph.
+//
+// Deprecated: Use HasCycleUndirected, which ignores edge directions and
+// counts every self-loop.
 func (g *Graph[T]) HasCycleUndirectedAdjMatrix() bool {
 	visited := map[T]struct{}{}
 	var dfs func(source T, parent T) bool
@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRemoveNodeAdjMatrix(t *testing.T) {
+	g := NewGraph[string](Directed, AdjacencyMatrix)
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+	g.RemoveNode("b")
+	if g.HasEdge("a", "c") || !g.HasEdge("c", "a") {
+		t.Errorf("after removing b: a->c %v, c->a %v, want false, true", g.HasEdge("a", "c"), g.HasEdge("c", "a"))
+	}
+	if want := [][2]string{{"c", "a"}}; !reflect.DeepEqual(g.Edges(), want) {
+		t.Errorf("Edges() = %v, want %v", g.Edges(), want)
+	}
+
+	wg := NewWeightedGraph[string, int](Directed, AdjacencyMatrix)
+	wg.AddEdge("a", "b", 1)
+	wg.AddEdge("b", "c", 2)
+	wg.AddEdge("c", "a", 3)
+	wg.RemoveNode("b")
+	if wg.HasEdge("a", "c") || !wg.HasEdge("c", "a") {
+		t.Errorf("weighted, after removing b: a->c %v, c->a %v, want false, true", wg.HasEdge("a", "c"), wg.HasEdge("c", "a"))
+	}
+}
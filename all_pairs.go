@@ -0,0 +1,186 @@
+package graph
+
+// AllPairsShortestPaths holds the result of FloydWarshall or Johnson. Nodes
+// fixes the index order of Dist and Next: Dist[i][j] is the cheapest cost
+// from Nodes[i] to Nodes[j] (Infinity[W]() if unreachable) and Next[i][j] is the index
+// of the node following Nodes[i] on that path (-1 if there is none).
+type AllPairsShortestPaths[T comparable, W Number] struct {
+	Nodes []T
+	Dist  [][]W
+	Next  [][]int
+	index map[T]int
+}
+
+func newAllPairsShortestPaths[T comparable, W Number](nodes []T) *AllPairsShortestPaths[T, W] {
+	n := len(nodes)
+	result := &AllPairsShortestPaths[T, W]{
+		Nodes: nodes,
+		Dist:  make([][]W, n),
+		Next:  make([][]int, n),
+		index: make(map[T]int, n),
+	}
+	inf := Infinity[W]()
+	for i, node := range nodes {
+		result.index[node] = i
+		result.Dist[i] = make([]W, n)
+		result.Next[i] = make([]int, n)
+		for j := 0; j < n; j++ {
+			result.Dist[i][j] = inf
+			result.Next[i][j] = -1
+		}
+	}
+	return result
+}
+
+// Distance returns the cheapest cost from u to v, or Infinity[W]() if there
+// is no path.
+func (r *AllPairsShortestPaths[T, W]) Distance(u T, v T) W {
+	i, ok := r.index[u]
+	if !ok {
+		return Infinity[W]()
+	}
+	j, ok := r.index[v]
+	if !ok {
+		return Infinity[W]()
+	}
+	return r.Dist[i][j]
+}
+
+// PathBetween reconstructs the cheapest path from u to v from the next-hop
+// matrix. It returns an empty path if v is unreachable from u.
+func (r *AllPairsShortestPaths[T, W]) PathBetween(u T, v T) []T {
+	i, ok := r.index[u]
+	if !ok {
+		return []T{}
+	}
+	j, ok := r.index[v]
+	if !ok {
+		return []T{}
+	}
+	if i == j {
+		return []T{u}
+	}
+	if r.Next[i][j] == -1 {
+		return []T{}
+	}
+	path := []T{u}
+	for i != j {
+		i = r.Next[i][j]
+		path = append(path, r.Nodes[i])
+	}
+	return path
+}
+
+func (g *WeightedGraph[T, W]) FloydWarshall() (*AllPairsShortestPaths[T, W], error) {
+	return FloydWarshall[T, W](g)
+}
+
+func (g *WeightedGraph[T, W]) Johnson() (*AllPairsShortestPaths[T, W], error) {
+	return Johnson[T, W](g)
+}
+
+// FloydWarshall computes the cheapest path between every pair of nodes. The
+// result follows the order of g.Nodes(), which is the matrix index order for
+// AdjacencyMatrix graphs. Negative weights are allowed; a negative cycle is
+// reported as a *NegativeCycleError.
+func FloydWarshall[T comparable, W Number](g WeightedTraversable[T, W]) (*AllPairsShortestPaths[T, W], error) {
+	result := newAllPairsShortestPaths[T, W](g.Nodes())
+	n := len(result.Nodes)
+	inf := Infinity[W]()
+	for i, u := range result.Nodes {
+		result.Dist[i][i] = 0
+		result.Next[i][i] = i
+		for _, v := range g.Neighbours(u) {
+			j := result.index[v]
+			if weight, _ := g.Weight(u, v); weight < result.Dist[i][j] {
+				result.Dist[i][j] = weight
+				result.Next[i][j] = j
+			}
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if result.Dist[i][k] == inf {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if result.Dist[k][j] == inf {
+					continue
+				}
+				if alt := result.Dist[i][k] + result.Dist[k][j]; alt < result.Dist[i][j] {
+					result.Dist[i][j] = alt
+					result.Next[i][j] = result.Next[i][k]
+				}
+			}
+		}
+	}
+
+	for i, node := range result.Nodes {
+		if result.Dist[i][i] < 0 {
+			_, _, err := BellmanFord(g, node)
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Johnson computes the cheapest path between every pair of nodes by
+// reweighting the edges with Bellman-Ford potentials and then running
+// Dijkstra from every node. It is intended for sparse AdjacencyList graphs
+// with negative weights; a negative cycle is reported as a
+// *NegativeCycleError.
+func Johnson[T comparable, W Number](g WeightedTraversable[T, W]) (*AllPairsShortestPaths[T, W], error) {
+	nodes := g.Nodes()
+	potential := make(map[T]W, len(nodes))
+	for _, node := range nodes {
+		potential[node] = 0
+	}
+	if err := bellmanFord(g, potential, map[T]T{}); err != nil {
+		return nil, err
+	}
+	weight := weightOf(g)
+	reweighted := func(from T, to T) W {
+		return weight(from, to) + potential[from] - potential[to]
+	}
+
+	result := newAllPairsShortestPaths[T, W](nodes)
+	for i, source := range result.Nodes {
+		dist, prev := dijkstra(g, source, nil, reweighted)
+		first := map[T]T{}
+		for target, d := range dist {
+			j := result.index[target]
+			result.Dist[i][j] = d - potential[source] + potential[target]
+			if target == source {
+				result.Next[i][j] = i
+				continue
+			}
+			result.Next[i][j] = result.index[firstHop(prev, first, source, target)]
+		}
+	}
+	return result, nil
+}
+
+// firstHop returns the node following source on the path to target in the
+// predecessor tree prev, memoising results in first.
+func firstHop[T comparable](prev map[T]T, first map[T]T, source T, target T) T {
+	chain := []T{}
+	node := target
+	var hop T
+	for {
+		if h, ok := first[node]; ok {
+			hop = h
+			break
+		}
+		chain = append(chain, node)
+		if prev[node] == source {
+			hop = node
+			break
+		}
+		node = prev[node]
+	}
+	for _, n := range chain {
+		first[n] = hop
+	}
+	return hop
+}
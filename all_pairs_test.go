@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestAllPairsShortestPaths(t *testing.T) {
+	edges := []weightedEdgeCase{
+		{"a", "b", 3}, {"a", "c", 8}, {"b", "c", -2}, {"c", "d", 1}, {"d", "a", 2}, {"b", "d", 5},
+	}
+	tests := []struct {
+		u, v string
+		dist int
+		path []string
+	}{
+		{"a", "d", 2, []string{"a", "b", "c", "d"}},
+		{"d", "c", 3, []string{"d", "a", "b", "c"}},
+		{"c", "b", 6, []string{"c", "d", "a", "b"}},
+		{"b", "b", 0, []string{"b"}},
+		{"a", "z", Infinity[int](), []string{}},
+		{"z", "a", Infinity[int](), []string{}},
+		{"a", "missing", Infinity[int](), []string{}},
+	}
+	algorithms := map[string]func(*WeightedGraph[string, int]) (*AllPairsShortestPaths[string, int], error){
+		"FloydWarshall": (*WeightedGraph[string, int]).FloydWarshall,
+		"Johnson":       (*WeightedGraph[string, int]).Johnson,
+	}
+	for _, repType := range representations {
+		for name, apsp := range algorithms {
+			g := buildWeighted(Directed, repType, edges)
+			g.AddNode("z")
+			result, err := apsp(g)
+			if err != nil {
+				t.Fatalf("%v/%s: %v", repType, name, err)
+			}
+			if repType == AdjacencyMatrix && !slices.Equal(result.Nodes, g.Nodes()) {
+				t.Errorf("%v/%s: Nodes = %v, want matrix order %v", repType, name, result.Nodes, g.Nodes())
+			}
+			for _, tt := range tests {
+				if got := result.Distance(tt.u, tt.v); got != tt.dist {
+					t.Errorf("%v/%s: Distance(%s, %s) = %d, want %d", repType, name, tt.u, tt.v, got, tt.dist)
+				}
+				if got := result.PathBetween(tt.u, tt.v); !slices.Equal(got, tt.path) {
+					t.Errorf("%v/%s: PathBetween(%s, %s) = %v, want %v", repType, name, tt.u, tt.v, got, tt.path)
+				}
+			}
+		}
+	}
+}
+
+func TestAllPairsNegativeCycle(t *testing.T) {
+	g := buildWeighted(Directed, AdjacencyList, []weightedEdgeCase{{"a", "b", 1}, {"b", "c", -3}, {"c", "a", 1}})
+	if _, err := g.FloydWarshall(); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("FloydWarshall: err = %v, want a negative cycle", err)
+	}
+	if _, err := g.Johnson(); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("Johnson: err = %v, want a negative cycle", err)
+	}
+}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+func (g *WeightedGraph[T, W]) AStar(source T, target T, heuristic func(T) W) (path []T, cost W, expanded int) {
+	return AStar[T, W](g, source, target, heuristic)
+}
+
+// AStar finds the cheapest path from source to target, guided by heuristic,
+// an estimate of the remaining cost from a node to target. The heuristic must
+// be admissible (never overestimate) for the result to be optimal; building
+// with the graphdebug tag checks this against the true distances. It returns
+// an empty path and Infinity[W]() if target is unreachable, along with the number of
+// nodes expanded during the search.
+func AStar[T comparable, W Number](g WeightedTraversable[T, W], source T, target T, heuristic func(T) W) (path []T, cost W, expanded int) {
+	if debug {
+		checkAdmissible(g, target, heuristic)
+	}
+	if !g.HasNode(source) || !g.HasNode(target) {
+		return []T{}, Infinity[W](), 0
+	}
+	dist := map[T]W{source: 0}
+	prev := map[T]T{}
+	pq := &priorityQueue[T, W]{{node: source, priority: heuristic(source)}}
+	for pq.Len() > 0 {
+		curr := heap.Pop(pq).(pqItem[T, W])
+		if curr.priority != dist[curr.node]+heuristic(curr.node) {
+			continue
+		}
+		expanded++
+		if curr.node == target {
+			return buildPath(prev, source, target), dist[target], expanded
+		}
+		for _, nbr := range g.Neighbours(curr.node) {
+			weight, _ := g.Weight(curr.node, nbr)
+			alt := dist[curr.node] + weight
+			if d, seen := dist[nbr]; !seen || alt < d {
+				dist[nbr] = alt
+				prev[nbr] = curr.node
+				heap.Push(pq, pqItem[T, W]{node: nbr, priority: alt + heuristic(nbr)})
+			}
+		}
+	}
+	return []T{}, Infinity[W](), expanded
+}
+
+// checkAdmissible panics if heuristic overestimates the true cost from any
+// node to target.
+func checkAdmissible[T comparable, W Number](g WeightedTraversable[T, W], target T, heuristic func(T) W) {
+	reversed := NewWeightedGraph[T, W](Directed, AdjacencyList)
+	for _, node := range g.Nodes() {
+		reversed.AddNode(node)
+	}
+	for _, e := range arcs(g) {
+		reversed.AddEdge(e.Edge[1], e.Edge[0], e.Weight)
+	}
+	dist, _ := reversed.Dijkstra(target)
+	for node, d := range dist {
+		if d != Infinity[W]() && heuristic(node) > d {
+			panic(fmt.Sprintf("graph: A* heuristic is not admissible: h(%v) = %v > %v", node, heuristic(node), d))
+		}
+	}
+}
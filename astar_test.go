@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+type cell struct{ x, y int }
+
+// gridGraph returns a w by h grid of unit-weight edges with the cells in
+// walls left out.
+func gridGraph(repType RepresentationType, w, h int, walls ...cell) *WeightedGraph[cell, int] {
+	g := NewWeightedGraph[cell, int](Undirected, repType)
+	open := func(c cell) bool {
+		return c.x < w && c.y < h && !slices.Contains(walls, c)
+	}
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			c := cell{x, y}
+			if !open(c) {
+				continue
+			}
+			g.AddNode(c)
+			for _, next := range []cell{{x + 1, y}, {x, y + 1}} {
+				if open(next) {
+					g.AddEdge(c, next, 1)
+				}
+			}
+		}
+	}
+	return g
+}
+
+func manhattan(target cell) func(cell) int {
+	return func(c cell) int {
+		return abs(c.x-target.x) + abs(c.y-target.y)
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func TestAStar(t *testing.T) {
+	walls := []cell{{2, 0}, {2, 1}, {2, 2}, {2, 3}}
+	tests := []struct {
+		name           string
+		source, target cell
+		cost           int
+	}{
+		{"around the wall", cell{0, 0}, cell{4, 0}, 12},
+		{"same cell", cell{1, 1}, cell{1, 1}, 0},
+		{"straight line", cell{3, 0}, cell{3, 4}, 4},
+	}
+	for _, repType := range representations {
+		g := gridGraph(repType, 5, 5, walls...)
+		for _, tt := range tests {
+			path, cost, expanded := g.AStar(tt.source, tt.target, manhattan(tt.target))
+			_, want := g.DijkstraShortestPath(tt.source, tt.target)
+			if cost != tt.cost || cost != want {
+				t.Errorf("%v/%s: cost %d, want %d (Dijkstra %d)", repType, tt.name, cost, tt.cost, want)
+			}
+			if len(path) != cost+1 || path[0] != tt.source || path[len(path)-1] != tt.target {
+				t.Errorf("%v/%s: bad path %v", repType, tt.name, path)
+			}
+			if expanded < 1 || expanded > len(g.Nodes()) {
+				t.Errorf("%v/%s: expanded %d nodes", repType, tt.name, expanded)
+			}
+		}
+	}
+}
+
+func TestAStarHeuristicPrunes(t *testing.T) {
+	g := gridGraph(AdjacencyList, 20, 20)
+	target := cell{19, 0}
+	_, _, guided := g.AStar(cell{0, 0}, target, manhattan(target))
+	_, _, blind := g.AStar(cell{0, 0}, target, func(cell) int { return 0 })
+	if guided >= blind {
+		t.Errorf("heuristic search expanded %d nodes, blind search %d", guided, blind)
+	}
+}
+
+func TestAStarUnreachable(t *testing.T) {
+	g := gridGraph(AdjacencyMatrix, 3, 1)
+	g.AddNode(cell{9, 9})
+	path, cost, _ := g.AStar(cell{0, 0}, cell{9, 9}, func(cell) int { return 0 })
+	if len(path) != 0 || cost != Infinity[int]() {
+		t.Errorf("got %v, %d", path, cost)
+	}
+	if path, _, expanded := g.AStar(cell{0, 0}, cell{7, 7}, func(cell) int { return 0 }); len(path) != 0 || expanded != 0 {
+		t.Errorf("missing target: got %v after %d expansions", path, expanded)
+	}
+}
+
+func TestCheckAdmissible(t *testing.T) {
+	g := gridGraph(AdjacencyList, 3, 3)
+	target := cell{2, 2}
+	checkAdmissible[cell, int](g, target, manhattan(target))
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("an overestimating heuristic did not panic")
+		}
+	}()
+	checkAdmissible[cell, int](g, target, func(c cell) int { return 10 * manhattan(target)(c) })
+}
@@ -0,0 +1,286 @@
+package graph
+
+// AttrKey names an attribute whose values have type V. Declaring a key once,
+//
+//	const Label graph.AttrKey[string] = "label"
+//
+// lets the compiler check the value type of every SetNodeAttr, NodeAttr,
+// SetEdgeAttr and EdgeAttr call made with it. An untyped string constant can
+// stand in for a key; V is then inferred from the value or given explicitly.
+type AttrKey[V any] string
+
+// Attributed is implemented by Graph, WeightedGraph and CSRGraph, and is what
+// NodeAttr and EdgeAttr read from. It is sealed: the attributes live in
+// storage only this package can reach, so other types cannot implement it.
+type Attributed[T comparable] interface {
+	IsDirected() bool
+	NodeAttrs(node T) map[string]any
+	EdgeAttrs(from T, to T) map[string]any
+	attributeStore() *attributes[T]
+}
+
+// MutableAttributed is implemented by Graph and WeightedGraph, and is what
+// SetNodeAttr, SetEdgeAttr, DeleteNodeAttr and DeleteEdgeAttr write to. It is
+// sealed like Attributed.
+type MutableAttributed[T comparable] interface {
+	Traversable[T]
+	Attributed[T]
+	unshare()
+}
+
+var (
+	_ MutableAttributed[int] = (*Graph[int])(nil)
+	_ MutableAttributed[int] = (*WeightedGraph[int, float64])(nil)
+	_ Attributed[int]        = (*CSRGraph[int, float64])(nil)
+)
+
+// attributes holds the node and edge attributes of a graph; its zero value is
+// empty and ready to use. Values are stored by key name and typed by the
+// AttrKey they are read and written with. An undirected edge is stored under
+// the orientation its first attribute was set with and found from either end.
+type attributes[T comparable] struct {
+	nodes map[T]map[string]any
+	edges map[[2]T]map[string]any
+}
+
+func (a *attributes[T]) edgeKey(from T, to T, directed bool) [2]T {
+	if !directed {
+		if _, ok := a.edges[[2]T{to, from}]; ok {
+			return [2]T{to, from}
+		}
+	}
+	return [2]T{from, to}
+}
+
+func (a *attributes[T]) node(node T, key string) (any, bool) {
+	value, ok := a.nodes[node][key]
+	return value, ok
+}
+
+func (a *attributes[T]) edge(from T, to T, directed bool, key string) (any, bool) {
+	value, ok := a.edges[a.edgeKey(from, to, directed)][key]
+	return value, ok
+}
+
+func (a *attributes[T]) setNode(node T, key string, value any) {
+	if a.nodes == nil {
+		a.nodes = map[T]map[string]any{}
+	}
+	if a.nodes[node] == nil {
+		a.nodes[node] = map[string]any{}
+	}
+	a.nodes[node][key] = value
+}
+
+func (a *attributes[T]) setEdge(from T, to T, directed bool, key string, value any) {
+	if a.edges == nil {
+		a.edges = map[[2]T]map[string]any{}
+	}
+	edge := a.edgeKey(from, to, directed)
+	if a.edges[edge] == nil {
+		a.edges[edge] = map[string]any{}
+	}
+	a.edges[edge][key] = value
+}
+
+func (a *attributes[T]) deleteNode(node T, key string) {
+	delete(a.nodes[node], key)
+	if len(a.nodes[node]) == 0 {
+		delete(a.nodes, node)
+	}
+}
+
+func (a *attributes[T]) deleteEdge(from T, to T, directed bool, key string) {
+	edge := a.edgeKey(from, to, directed)
+	delete(a.edges[edge], key)
+	if len(a.edges[edge]) == 0 {
+		delete(a.edges, edge)
+	}
+}
+
+// removeNode drops the attributes of node and of every edge touching it.
+func (a *attributes[T]) removeNode(node T) {
+	delete(a.nodes, node)
+	for edge := range a.edges {
+		if edge[0] == node || edge[1] == node {
+			delete(a.edges, edge)
+		}
+	}
+}
+
+func (a *attributes[T]) removeEdge(from T, to T, directed bool) {
+	delete(a.edges, [2]T{from, to})
+	if !directed {
+		delete(a.edges, [2]T{to, from})
+	}
+}
+
+// nodeEntries returns a copy of the attributes removeNode drops for node, for
+// restore to put back.
+func (a *attributes[T]) nodeEntries(node T) attributes[T] {
+	entries := attributes[T]{}
+	if attrs, ok := a.nodes[node]; ok {
+		entries.setNodeAttrs(node, attrs)
+	}
+	for edge, attrs := range a.edges {
+		if edge[0] == node || edge[1] == node {
+			entries.setEdgeAttrs(edge, attrs)
+		}
+	}
+	return entries
+}
+
+// edgeEntries returns a copy of the attributes removeEdge drops.
+func (a *attributes[T]) edgeEntries(from T, to T, directed bool) attributes[T] {
+	entries := attributes[T]{}
+	edge := a.edgeKey(from, to, directed)
+	if attrs, ok := a.edges[edge]; ok {
+		entries.setEdgeAttrs(edge, attrs)
+	}
+	return entries
+}
+
+// restore puts back a copy of entries taken by nodeEntries or edgeEntries.
+func (a *attributes[T]) restore(entries attributes[T]) {
+	for node, attrs := range entries.nodes {
+		a.setNodeAttrs(node, attrs)
+	}
+	for edge, attrs := range entries.edges {
+		a.setEdgeAttrs(edge, attrs)
+	}
+}
+
+func (a *attributes[T]) setNodeAttrs(node T, attrs map[string]any) {
+	if a.nodes == nil {
+		a.nodes = map[T]map[string]any{}
+	}
+	a.nodes[node] = copyAttrs(attrs)
+}
+
+func (a *attributes[T]) setEdgeAttrs(edge [2]T, attrs map[string]any) {
+	if a.edges == nil {
+		a.edges = map[[2]T]map[string]any{}
+	}
+	a.edges[edge] = copyAttrs(attrs)
+}
+
+// clone copies the attribute maps; the values themselves are shared.
+func (a *attributes[T]) clone() attributes[T] {
+	result := attributes[T]{}
+	if a.nodes != nil {
+		result.nodes = make(map[T]map[string]any, len(a.nodes))
+		for node, attrs := range a.nodes {
+			result.nodes[node] = copyAttrs(attrs)
+		}
+	}
+	if a.edges != nil {
+		result.edges = make(map[[2]T]map[string]any, len(a.edges))
+		for edge, attrs := range a.edges {
+			result.edges[edge] = copyAttrs(attrs)
+		}
+	}
+	return result
+}
+
+// copyAttrs returns a copy of attrs.
+func copyAttrs(attrs map[string]any) map[string]any {
+	result := make(map[string]any, len(attrs))
+	for key, value := range attrs {
+		result[key] = value
+	}
+	return result
+}
+
+// attrAs returns a stored attribute value as a V.
+func attrAs[V any](value any, ok bool) (V, bool) {
+	typed, isV := value.(V)
+	return typed, ok && isV
+}
+
+// SetNodeAttr sets the attribute key of node to value. It returns an error
+// wrapping ErrNodeNotFound if node is not in g.
+func SetNodeAttr[V any, T comparable](g MutableAttributed[T], node T, key AttrKey[V], value V) error {
+	if err := requireNodes(g, node); err != nil {
+		return err
+	}
+	g.unshare()
+	g.attributeStore().setNode(node, string(key), value)
+	return nil
+}
+
+// NodeAttr returns the attribute key of node. It reports false if the
+// attribute is not set or was set with a key of another value type.
+func NodeAttr[V any, T comparable](g Attributed[T], node T, key AttrKey[V]) (V, bool) {
+	return attrAs[V](g.attributeStore().node(node, string(key)))
+}
+
+// DeleteNodeAttr removes the attribute key of node, if it is set.
+func DeleteNodeAttr[V any, T comparable](g MutableAttributed[T], node T, key AttrKey[V]) {
+	g.unshare()
+	g.attributeStore().deleteNode(node, string(key))
+}
+
+// SetEdgeAttr sets the attribute key of the edge from from to to. For
+// undirected graphs both orientations name the same edge. It returns an
+// error wrapping ErrNodeNotFound or ErrEdgeNotFound if there is no such edge.
+func SetEdgeAttr[V any, T comparable](g MutableAttributed[T], from T, to T, key AttrKey[V], value V) error {
+	if err := requireEdge(g, from, to); err != nil {
+		return err
+	}
+	g.unshare()
+	g.attributeStore().setEdge(from, to, g.IsDirected(), string(key), value)
+	return nil
+}
+
+// EdgeAttr returns the attribute key of the edge from from to to. It reports
+// false if the attribute is not set or was set with a key of another value
+// type.
+func EdgeAttr[V any, T comparable](g Attributed[T], from T, to T, key AttrKey[V]) (V, bool) {
+	return attrAs[V](g.attributeStore().edge(from, to, g.IsDirected(), string(key)))
+}
+
+// DeleteEdgeAttr removes the attribute key of the edge from from to to, if it
+// is set.
+func DeleteEdgeAttr[V any, T comparable](g MutableAttributed[T], from T, to T, key AttrKey[V]) {
+	g.unshare()
+	g.attributeStore().deleteEdge(from, to, g.IsDirected(), string(key))
+}
+
+func (g *Graph[T]) attributeStore() *attributes[T] {
+	return &g.attrs
+}
+
+// NodeAttrs returns a copy of every attribute of node, keyed by name.
+func (g *Graph[T]) NodeAttrs(node T) map[string]any {
+	return copyAttrs(g.attrs.nodes[node])
+}
+
+// EdgeAttrs returns a copy of every attribute of the edge from from to to,
+// keyed by name.
+func (g *Graph[T]) EdgeAttrs(from T, to T) map[string]any {
+	return copyAttrs(g.attrs.edges[g.attrs.edgeKey(from, to, g.IsDirected())])
+}
+
+func (g *WeightedGraph[T, W]) attributeStore() *attributes[T] {
+	return &g.attrs
+}
+
+func (g *WeightedGraph[T, W]) NodeAttrs(node T) map[string]any {
+	return copyAttrs(g.attrs.nodes[node])
+}
+
+func (g *WeightedGraph[T, W]) EdgeAttrs(from T, to T) map[string]any {
+	return copyAttrs(g.attrs.edges[g.attrs.edgeKey(from, to, g.IsDirected())])
+}
+
+func (g *CSRGraph[T, W]) attributeStore() *attributes[T] {
+	return &g.attrs
+}
+
+func (g *CSRGraph[T, W]) NodeAttrs(node T) map[string]any {
+	return copyAttrs(g.attrs.nodes[node])
+}
+
+func (g *CSRGraph[T, W]) EdgeAttrs(from T, to T) map[string]any {
+	return copyAttrs(g.attrs.edges[g.attrs.edgeKey(from, to, g.IsDirected())])
+}
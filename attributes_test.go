@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+const (
+	colourKey   AttrKey[string] = "colour"
+	capacityKey AttrKey[int]    = "capacity"
+)
+
+func TestAttributes(t *testing.T) {
+	for _, graphType := range []GraphType{Directed, Undirected} {
+		for _, repType := range representations {
+			g := NewWeightedGraph[string, float64](graphType, repType)
+			g.AddEdge("a", "b", 1.5)
+			if err := SetNodeAttr(g, "a", colourKey, "red"); err != nil {
+				t.Fatal(err)
+			}
+			if err := SetEdgeAttr(g, "a", "b", capacityKey, 10); err != nil {
+				t.Fatal(err)
+			}
+			if err := SetNodeAttr(g, "z", colourKey, "red"); !errors.Is(err, ErrNodeNotFound) {
+				t.Errorf("SetNodeAttr on missing node: err = %v", err)
+			}
+			if err := SetEdgeAttr(g, "b", "b", "k", 1); !errors.Is(err, ErrEdgeNotFound) {
+				t.Errorf("SetEdgeAttr on missing edge: err = %v", err)
+			}
+
+			tests := []struct {
+				name     string
+				from, to string
+				ok       bool
+			}{
+				{"as set", "a", "b", true},
+				{"reversed", "b", "a", graphType == Undirected},
+			}
+			for _, tt := range tests {
+				v, ok := EdgeAttr(g, tt.from, tt.to, capacityKey)
+				if ok != tt.ok || (ok && v != 10) {
+					t.Errorf("%v/%v/%s: EdgeAttr = %d, %v, want ok %v", graphType, repType, tt.name, v, ok, tt.ok)
+				}
+			}
+			if _, ok := NodeAttr(g, "a", AttrKey[int](colourKey)); ok {
+				t.Errorf("NodeAttr with the wrong type reported ok")
+			}
+			if got := g.NodeAttrs("a"); len(got) != 1 || got["colour"] != "red" {
+				t.Errorf("NodeAttrs = %v", got)
+			}
+			DeleteNodeAttr(g, "a", colourKey)
+			if _, ok := NodeAttr(g, "a", colourKey); ok {
+				t.Errorf("DeleteNodeAttr left the attribute")
+			}
+		}
+	}
+}
+
+func TestAttributesRemovedWithStorage(t *testing.T) {
+	removers := []struct {
+		name       string
+		removeNode func(g *Graph[int], node int)
+		removeEdge func(g *Graph[int], from, to int)
+	}{
+		{"RemoveNode", func(g *Graph[int], n int) { g.RemoveNode(n) }, func(g *Graph[int], f, t int) { g.RemoveEdge(f, t) }},
+		{"AdjList", (*Graph[int]).RemoveNodeAdjList, (*Graph[int]).RemoveEdgeAdjList},
+		{"AdjMatrix", (*Graph[int]).RemoveNodeAdjMatrix, (*Graph[int]).RemoveEdgeAdjMatrix},
+	}
+	for _, r := range removers {
+		repType := AdjacencyList
+		if r.name == "AdjMatrix" {
+			repType = AdjacencyMatrix
+		}
+		g := NewGraph[int](Undirected, repType)
+		g.AddEdge(1, 2)
+		g.AddEdge(2, 3)
+		SetNodeAttr(g, 1, "k", "stale")
+		SetEdgeAttr(g, 1, 2, "k", "stale")
+		SetEdgeAttr(g, 2, 3, "k", "stale")
+
+		r.removeEdge(g, 3, 2)
+		g.AddEdge(2, 3)
+		if v, ok := EdgeAttr[string](g, 2, 3, "k"); ok {
+			t.Errorf("%s: edge attribute survived removal: %v", r.name, v)
+		}
+		r.removeNode(g, 1)
+		g.AddEdge(1, 2)
+		if v, ok := NodeAttr[string](g, 1, "k"); ok {
+			t.Errorf("%s: node attribute survived removal: %v", r.name, v)
+		}
+		if v, ok := EdgeAttr[string](g, 1, 2, "k"); ok {
+			t.Errorf("%s: incident edge attribute survived removal: %v", r.name, v)
+		}
+	}
+
+	w := NewWeightedGraph[int, int](Directed, AdjacencyMatrix)
+	w.AddEdge(1, 2, 4)
+	SetNodeAttr(w, 2, "k", "stale")
+	SetEdgeAttr(w, 1, 2, "k", "stale")
+	w.RemoveNodeAdjMatrix(2)
+	w.AddEdge(1, 2, 4)
+	if _, ok := NodeAttr[string](w, 2, "k"); ok {
+		t.Errorf("weighted: node attribute survived RemoveNodeAdjMatrix")
+	}
+	if _, ok := EdgeAttr[string](w, 1, 2, "k"); ok {
+		t.Errorf("weighted: edge attribute survived RemoveNodeAdjMatrix")
+	}
+}
+
+func TestAttributesSurviveConversionAndClone(t *testing.T) {
+	g := NewGraph[string](Directed, AdjacencyList)
+	g.AddEdge("x", "y")
+	SetNodeAttr(g, "x", "n", 1)
+	SetEdgeAttr(g, "x", "y", "e", 2)
+	c := g.Clone()
+	if err := g.ConvertTo(AdjacencyMatrix); err != nil {
+		t.Fatal(err)
+	}
+	for name, h := range map[string]*Graph[string]{"converted": g, "clone": c} {
+		if v, ok := NodeAttr[int](h, "x", "n"); !ok || v != 1 {
+			t.Errorf("%s: node attribute = %v, %v", name, v, ok)
+		}
+		if v, ok := EdgeAttr[int](h, "x", "y", "e"); !ok || v != 2 {
+			t.Errorf("%s: edge attribute = %v, %v", name, v, ok)
+		}
+	}
+	SetNodeAttr(c, "x", "n", 5)
+	if v, _ := NodeAttr[int](g, "x", "n"); v != 1 {
+		t.Errorf("clone shares attribute storage")
+	}
+	csr := g.Freeze()
+	if v, ok := EdgeAttr[int](csr, "x", "y", "e"); !ok || v != 2 {
+		t.Errorf("frozen: edge attribute = %v, %v", v, ok)
+	}
+}
@@ -0,0 +1,99 @@
+package graph
+
+import "fmt"
+
+// NegativeCycleError is returned by BellmanFord when a negative weight cycle
+// is reachable from the source. Cycle lists the nodes in edge order; the last
+// node has an edge back to the first. It matches ErrNegativeWeight under
+// errors.Is.
+type NegativeCycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *NegativeCycleError[T]) Error() string {
+	return fmt.Sprintf("graph: negative cycle %v", e.Cycle)
+}
+
+func (e *NegativeCycleError[T]) Unwrap() error {
+	return ErrNegativeWeight
+}
+
+func (g *WeightedGraph[T, W]) BellmanFord(source T) (map[T]W, map[T]T, error) {
+	return BellmanFord[T, W](g, source)
+}
+
+// BellmanFord computes the cheapest distance from source to every node and
+// supports negative edge weights. Unreachable nodes are reported with a
+// distance of Infinity[W](). If a negative cycle is reachable from source it
+// returns a *NegativeCycleError describing the cycle, and if source is not in
+// the graph an error wrapping ErrNodeNotFound.
+func BellmanFord[T comparable, W Number](g WeightedTraversable[T, W], source T) (map[T]W, map[T]T, error) {
+	nodes := g.Nodes()
+	dist := make(map[T]W, len(nodes))
+	prev := map[T]T{}
+	for _, node := range nodes {
+		dist[node] = Infinity[W]()
+	}
+	if err := requireNodes(g, source); err != nil {
+		return nil, nil, err
+	}
+	dist[source] = 0
+	if err := bellmanFord(g, dist, prev); err != nil {
+		return nil, nil, err
+	}
+	return dist, prev, nil
+}
+
+// bellmanFord relaxes every edge against the starting distances in dist until
+// nothing changes, updating dist and prev in place.
+func bellmanFord[T comparable, W Number](g WeightedTraversable[T, W], dist map[T]W, prev map[T]T) error {
+	edges := arcs(g)
+	n := len(dist)
+	inf := Infinity[W]()
+	for i := 0; i < n-1; i++ {
+		changed := false
+		for _, e := range edges {
+			from, to := e.Edge[0], e.Edge[1]
+			if dist[from] == inf {
+				continue
+			}
+			if alt := dist[from] + e.Weight; alt < dist[to] {
+				dist[to] = alt
+				prev[to] = from
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+
+	for _, e := range edges {
+		from, to := e.Edge[0], e.Edge[1]
+		if dist[from] == inf {
+			continue
+		}
+		if dist[from]+e.Weight < dist[to] {
+			prev[to] = from
+			return &NegativeCycleError[T]{Cycle: negativeCycle(prev, to, n)}
+		}
+	}
+	return nil
+}
+
+// negativeCycle follows the predecessor chain from start far enough to be
+// sure it is inside the cycle, then collects the cycle in edge order.
+func negativeCycle[T comparable](prev map[T]T, start T, n int) []T {
+	node := start
+	for i := 0; i < n; i++ {
+		node = prev[node]
+	}
+	cycle := []T{node}
+	for curr := prev[node]; curr != node; curr = prev[curr] {
+		cycle = append(cycle, curr)
+	}
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return cycle
+}
@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBellmanFord(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges []weightedEdgeCase
+		dist  map[string]int
+		cycle bool
+	}{
+		{
+			name:  "rebate shortcut",
+			edges: []weightedEdgeCase{{"s", "a", 4}, {"s", "b", 5}, {"b", "a", -3}, {"a", "c", 2}},
+			dist:  map[string]int{"s": 0, "a": 2, "b": 5, "c": 4, "z": Infinity[int]()},
+		},
+		{
+			name:  "negative cycle",
+			edges: []weightedEdgeCase{{"s", "a", 1}, {"a", "b", 1}, {"b", "c", -4}, {"c", "a", 1}},
+			cycle: true,
+		},
+		{
+			name:  "unreachable negative cycle",
+			edges: []weightedEdgeCase{{"s", "a", 1}, {"x", "y", -2}, {"y", "x", 1}},
+			dist:  map[string]int{"s": 0, "a": 1, "x": Infinity[int]()},
+		},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := buildWeighted(Directed, repType, tt.edges)
+			g.AddNode("z")
+			dist, prev, err := g.BellmanFord("s")
+			if tt.cycle {
+				var cycleErr *NegativeCycleError[string]
+				if !errors.As(err, &cycleErr) || !errors.Is(err, ErrNegativeWeight) {
+					t.Fatalf("%s/%v: err = %v, want a NegativeCycleError", tt.name, repType, err)
+				}
+				checkNegativeCycle(t, g, cycleErr.Cycle)
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s/%v: %v", tt.name, repType, err)
+			}
+			for node, d := range tt.dist {
+				if dist[node] != d {
+					t.Errorf("%s/%v: dist[%s] = %d, want %d", tt.name, repType, node, dist[node], d)
+				}
+			}
+			if _, ok := prev["s"]; ok {
+				t.Errorf("%s/%v: source has a predecessor", tt.name, repType)
+			}
+		}
+	}
+}
+
+func TestBellmanFordMissingSource(t *testing.T) {
+	g := buildWeighted(Directed, AdjacencyList, []weightedEdgeCase{{"a", "b", 1}})
+	if _, _, err := g.BellmanFord("q"); !errors.Is(err, ErrNodeNotFound) {
+		t.Errorf("err = %v, want ErrNodeNotFound", err)
+	}
+}
+
+// checkNegativeCycle fails t unless cycle is a closed walk along edges of g
+// with negative total weight.
+func checkNegativeCycle(t *testing.T, g *WeightedGraph[string, int], cycle []string) {
+	t.Helper()
+	if len(cycle) == 0 {
+		t.Fatalf("empty cycle")
+	}
+	total := 0
+	for i, from := range cycle {
+		to := cycle[(i+1)%len(cycle)]
+		w, ok := g.Weight(from, to)
+		if !ok {
+			t.Fatalf("cycle %v uses missing edge %s -> %s", cycle, from, to)
+		}
+		total += w
+	}
+	if total >= 0 {
+		t.Errorf("cycle %v weighs %d, want negative", cycle, total)
+	}
+}
@@ -0,0 +1,723 @@
+package graph
+
+func (g *Graph[T]) MaximumMatching() [][2]T {
+	return MaximumMatching[T](g)
+}
+
+func (g *WeightedGraph[T, W]) MaximumMatching() [][2]T {
+	return MaximumMatching[T](g)
+}
+
+func (g *WeightedGraph[T, W]) MaximumWeightMatching() ([][2]T, W) {
+	return MaximumWeightMatching[T, W](g)
+}
+
+// MaximumMatching returns a maximum cardinality matching of an undirected
+// graph as node pairs, using Edmonds' blossom algorithm.
+func MaximumMatching[T comparable](g Traversable[T]) [][2]T {
+	return blossomMatching(g.Nodes(), g.Neighbours)
+}
+
+// MaximumWeightMatching returns a matching of an undirected graph that
+// maximises the total edge weight, and that weight. Edges with a
+// non-positive weight are never used. It runs the primal-dual weighted
+// blossom algorithm in O(n^3).
+func MaximumWeightMatching[T comparable, W Number](g WeightedTraversable[T, W]) ([][2]T, W) {
+	nodes := g.Nodes()
+	index := make(map[T]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	edges := [][2]int{}
+	weights := []W{}
+	for _, e := range edgesOf(g) {
+		if e.Edge[0] != e.Edge[1] && e.Weight > 0 {
+			edges = append(edges, [2]int{index[e.Edge[0]], index[e.Edge[1]]})
+			weights = append(weights, e.Weight)
+		}
+	}
+	mate := newWeightedMatcher(len(nodes), edges, weights).solve()
+	pairs := [][2]T{}
+	var total W
+	for i, j := range mate {
+		if j > i {
+			pairs = append(pairs, [2]T{nodes[i], nodes[j]})
+			weight, _ := g.Weight(nodes[i], nodes[j])
+			total += weight
+		}
+	}
+	return pairs, total
+}
+
+// blossomMatching grows alternating trees from every exposed node, shrinking
+// odd cycles (blossoms) into their base as they are found.
+func blossomMatching[T comparable](nodes []T, neighbours func(T) []T) [][2]T {
+	n := len(nodes)
+	index := make(map[T]int, n)
+	for i, node := range nodes {
+		index[node] = i
+	}
+	adj := make([][]int, n)
+	for i, node := range nodes {
+		for _, nbr := range neighbours(node) {
+			if j, ok := index[nbr]; ok && j != i {
+				adj[i] = append(adj[i], j)
+			}
+		}
+	}
+
+	match := make([]int, n)
+	parent := make([]int, n)
+	base := make([]int, n)
+	used := make([]bool, n)
+	inBlossom := make([]bool, n)
+	for i := range match {
+		match[i] = -1
+	}
+
+	lca := func(a int, b int) int {
+		seen := make([]bool, n)
+		for {
+			a = base[a]
+			seen[a] = true
+			if match[a] == -1 {
+				break
+			}
+			a = parent[match[a]]
+		}
+		for {
+			b = base[b]
+			if seen[b] {
+				return b
+			}
+			b = parent[match[b]]
+		}
+	}
+
+	markPath := func(v int, b int, child int) {
+		for base[v] != b {
+			inBlossom[base[v]] = true
+			inBlossom[base[match[v]]] = true
+			parent[v] = child
+			child = match[v]
+			v = parent[match[v]]
+		}
+	}
+
+	findPath := func(root int) int {
+		for i := 0; i < n; i++ {
+			used[i] = false
+			parent[i] = -1
+			base[i] = i
+		}
+		used[root] = true
+		queue := []int{root}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for _, to := range adj[v] {
+				if base[v] == base[to] || match[v] == to {
+					continue
+				}
+				if to == root || (match[to] != -1 && parent[match[to]] != -1) {
+					current := lca(v, to)
+					for i := range inBlossom {
+						inBlossom[i] = false
+					}
+					markPath(v, current, to)
+					markPath(to, current, v)
+					for i := 0; i < n; i++ {
+						if inBlossom[base[i]] {
+							base[i] = current
+							if !used[i] {
+								used[i] = true
+								queue = append(queue, i)
+							}
+						}
+					}
+				} else if parent[to] == -1 {
+					parent[to] = v
+					if match[to] == -1 {
+						return to
+					}
+					used[match[to]] = true
+					queue = append(queue, match[to])
+				}
+			}
+		}
+		return -1
+	}
+
+	for v := 0; v < n; v++ {
+		if match[v] != -1 {
+			continue
+		}
+		for u := findPath(v); u != -1; {
+			pv := parent[u]
+			next := match[pv]
+			match[u] = pv
+			match[pv] = u
+			u = next
+		}
+	}
+
+	pairs := [][2]T{}
+	for i, j := range match {
+		if j > i {
+			pairs = append(pairs, [2]T{nodes[i], nodes[j]})
+		}
+	}
+	return pairs
+}
+
+// weightedMatcher is a port of the classic O(n^3) maximum weight matching
+// algorithm (Galil, "Efficient algorithms for finding maximum matching in
+// graphs"). Vertices are 0..n-1 and blossoms n..2n-1. Every edge k has two
+// endpoints 2k and 2k+1; endpoint[p] is the vertex at endpoint p. Dual
+// variables are kept doubled so that integer weights stay integral.
+type weightedMatcher[W Number] struct {
+	n                int
+	edges            [][2]int
+	weight           []W
+	endpoint         []int
+	neighbend        [][]int
+	mate             []int
+	label            []int
+	labelEnd         []int
+	inBlossom        []int
+	blossomParent    []int
+	blossomChilds    [][]int
+	blossomBase      []int
+	blossomEndps     [][]int
+	bestEdge         []int
+	blossomBestEdges [][]int
+	unusedBlossoms   []int
+	dualVar          []W
+	allowEdge        []bool
+	queue            []int
+}
+
+func newWeightedMatcher[W Number](n int, edges [][2]int, weight []W) *weightedMatcher[W] {
+	m := &weightedMatcher[W]{
+		n:                n,
+		edges:            edges,
+		weight:           weight,
+		endpoint:         make([]int, 2*len(edges)),
+		neighbend:        make([][]int, n),
+		mate:             make([]int, n),
+		label:            make([]int, 2*n),
+		labelEnd:         make([]int, 2*n),
+		inBlossom:        make([]int, n),
+		blossomParent:    make([]int, 2*n),
+		blossomChilds:    make([][]int, 2*n),
+		blossomBase:      make([]int, 2*n),
+		blossomEndps:     make([][]int, 2*n),
+		bestEdge:         make([]int, 2*n),
+		blossomBestEdges: make([][]int, 2*n),
+		dualVar:          make([]W, 2*n),
+		allowEdge:        make([]bool, len(edges)),
+	}
+	var maxWeight W
+	for k, e := range edges {
+		m.endpoint[2*k] = e[0]
+		m.endpoint[2*k+1] = e[1]
+		m.neighbend[e[0]] = append(m.neighbend[e[0]], 2*k+1)
+		m.neighbend[e[1]] = append(m.neighbend[e[1]], 2*k)
+		maxWeight = max(maxWeight, weight[k])
+	}
+	for i := 0; i < n; i++ {
+		m.mate[i] = -1
+		m.inBlossom[i] = i
+		m.blossomBase[i] = i
+		m.blossomBase[n+i] = -1
+		m.dualVar[i] = maxWeight
+		m.unusedBlossoms = append(m.unusedBlossoms, n+i)
+	}
+	for i := 0; i < 2*n; i++ {
+		m.labelEnd[i] = -1
+		m.blossomParent[i] = -1
+		m.bestEdge[i] = -1
+	}
+	return m
+}
+
+// at indexes s like a Python list, so that negative j counts from the end.
+func at(s []int, j int) int {
+	if j < 0 {
+		return s[len(s)+j]
+	}
+	return s[j]
+}
+
+func indexOf(s []int, x int) int {
+	for i, v := range s {
+		if v == x {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *weightedMatcher[W]) slack(k int) W {
+	e := m.edges[k]
+	return m.dualVar[e[0]] + m.dualVar[e[1]] - 2*m.weight[k]
+}
+
+func (m *weightedMatcher[W]) blossomLeaves(b int) []int {
+	if b < m.n {
+		return []int{b}
+	}
+	leaves := []int{}
+	for _, t := range m.blossomChilds[b] {
+		leaves = append(leaves, m.blossomLeaves(t)...)
+	}
+	return leaves
+}
+
+// assignLabel labels the top-level blossom of w with t (1 = S, 2 = T),
+// reached through endpoint p.
+func (m *weightedMatcher[W]) assignLabel(w int, t int, p int) {
+	b := m.inBlossom[w]
+	m.label[w], m.label[b] = t, t
+	m.labelEnd[w], m.labelEnd[b] = p, p
+	m.bestEdge[w], m.bestEdge[b] = -1, -1
+	if t == 1 {
+		m.queue = append(m.queue, m.blossomLeaves(b)...)
+	} else if t == 2 {
+		base := m.blossomBase[b]
+		m.assignLabel(m.endpoint[m.mate[base]], 1, m.mate[base]^1)
+	}
+}
+
+// scanBlossom traces back from v and w to find either a new blossom base or,
+// if the two trees differ, -1 for an augmenting path.
+func (m *weightedMatcher[W]) scanBlossom(v int, w int) int {
+	path := []int{}
+	base := -1
+	for v != -1 || w != -1 {
+		b := m.inBlossom[v]
+		if m.label[b]&4 != 0 {
+			base = m.blossomBase[b]
+			break
+		}
+		path = append(path, b)
+		m.label[b] = 5
+		if m.labelEnd[b] == -1 {
+			v = -1
+		} else {
+			v = m.endpoint[m.labelEnd[b]]
+			b = m.inBlossom[v]
+			v = m.endpoint[m.labelEnd[b]]
+		}
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+	for _, b := range path {
+		m.label[b] = 1
+	}
+	return base
+}
+
+// addBlossom shrinks the cycle closed by edge k into a new blossom with the
+// given base.
+func (m *weightedMatcher[W]) addBlossom(base int, k int) {
+	v, w := m.edges[k][0], m.edges[k][1]
+	bb := m.inBlossom[base]
+	bv := m.inBlossom[v]
+	bw := m.inBlossom[w]
+	b := m.unusedBlossoms[len(m.unusedBlossoms)-1]
+	m.unusedBlossoms = m.unusedBlossoms[:len(m.unusedBlossoms)-1]
+	m.blossomBase[b] = base
+	m.blossomParent[b] = -1
+	m.blossomParent[bb] = b
+	path := []int{}
+	endps := []int{}
+	for bv != bb {
+		m.blossomParent[bv] = b
+		path = append(path, bv)
+		endps = append(endps, m.labelEnd[bv])
+		v = m.endpoint[m.labelEnd[bv]]
+		bv = m.inBlossom[v]
+	}
+	path = append(path, bb)
+	reverseInts(path)
+	reverseInts(endps)
+	endps = append(endps, 2*k)
+	for bw != bb {
+		m.blossomParent[bw] = b
+		path = append(path, bw)
+		endps = append(endps, m.labelEnd[bw]^1)
+		w = m.endpoint[m.labelEnd[bw]]
+		bw = m.inBlossom[w]
+	}
+	m.blossomChilds[b] = path
+	m.blossomEndps[b] = endps
+	m.label[b] = 1
+	m.labelEnd[b] = m.labelEnd[bb]
+	m.dualVar[b] = 0
+	for _, leaf := range m.blossomLeaves(b) {
+		if m.label[m.inBlossom[leaf]] == 2 {
+			m.queue = append(m.queue, leaf)
+		}
+		m.inBlossom[leaf] = b
+	}
+
+	bestEdgeTo := make([]int, 2*m.n)
+	for i := range bestEdgeTo {
+		bestEdgeTo[i] = -1
+	}
+	for _, child := range path {
+		var lists [][]int
+		if m.blossomBestEdges[child] == nil {
+			for _, leaf := range m.blossomLeaves(child) {
+				list := make([]int, 0, len(m.neighbend[leaf]))
+				for _, p := range m.neighbend[leaf] {
+					list = append(list, p/2)
+				}
+				lists = append(lists, list)
+			}
+		} else {
+			lists = [][]int{m.blossomBestEdges[child]}
+		}
+		for _, list := range lists {
+			for _, e := range list {
+				j := m.edges[e][1]
+				if m.inBlossom[j] == b {
+					j = m.edges[e][0]
+				}
+				bj := m.inBlossom[j]
+				if bj != b && m.label[bj] == 1 && (bestEdgeTo[bj] == -1 || m.slack(e) < m.slack(bestEdgeTo[bj])) {
+					bestEdgeTo[bj] = e
+				}
+			}
+		}
+		m.blossomBestEdges[child] = nil
+		m.bestEdge[child] = -1
+	}
+	best := []int{}
+	for _, e := range bestEdgeTo {
+		if e != -1 {
+			best = append(best, e)
+		}
+	}
+	m.blossomBestEdges[b] = best
+	m.bestEdge[b] = -1
+	for _, e := range best {
+		if m.bestEdge[b] == -1 || m.slack(e) < m.slack(m.bestEdge[b]) {
+			m.bestEdge[b] = e
+		}
+	}
+}
+
+// expandBlossom undoes blossom b, relabelling its children when it is
+// expanded in the middle of a stage.
+func (m *weightedMatcher[W]) expandBlossom(b int, endStage bool) {
+	for _, s := range m.blossomChilds[b] {
+		m.blossomParent[s] = -1
+		if s < m.n {
+			m.inBlossom[s] = s
+		} else if endStage && m.dualVar[s] == 0 {
+			m.expandBlossom(s, endStage)
+		} else {
+			for _, leaf := range m.blossomLeaves(s) {
+				m.inBlossom[leaf] = s
+			}
+		}
+	}
+	if !endStage && m.label[b] == 2 {
+		childs := m.blossomChilds[b]
+		endps := m.blossomEndps[b]
+		entryChild := m.inBlossom[m.endpoint[m.labelEnd[b]^1]]
+		j := indexOf(childs, entryChild)
+		var jStep, endpTrick int
+		if j&1 != 0 {
+			j -= len(childs)
+			jStep = 1
+			endpTrick = 0
+		} else {
+			jStep = -1
+			endpTrick = 1
+		}
+		p := m.labelEnd[b]
+		for j != 0 {
+			m.label[m.endpoint[p^1]] = 0
+			m.label[m.endpoint[at(endps, j-endpTrick)^endpTrick^1]] = 0
+			m.assignLabel(m.endpoint[p^1], 2, p)
+			m.allowEdge[at(endps, j-endpTrick)/2] = true
+			j += jStep
+			p = at(endps, j-endpTrick) ^ endpTrick
+			m.allowEdge[p/2] = true
+			j += jStep
+		}
+		bv := at(childs, j)
+		m.label[m.endpoint[p^1]], m.label[bv] = 2, 2
+		m.labelEnd[m.endpoint[p^1]], m.labelEnd[bv] = p, p
+		m.bestEdge[bv] = -1
+		j += jStep
+		for at(childs, j) != entryChild {
+			bv = at(childs, j)
+			if m.label[bv] == 1 {
+				j += jStep
+				continue
+			}
+			labelled := -1
+			for _, leaf := range m.blossomLeaves(bv) {
+				if m.label[leaf] != 0 {
+					labelled = leaf
+					break
+				}
+			}
+			if labelled != -1 {
+				m.label[labelled] = 0
+				m.label[m.endpoint[m.mate[m.blossomBase[bv]]]] = 0
+				m.assignLabel(labelled, 2, m.labelEnd[labelled])
+			}
+			j += jStep
+		}
+	}
+	m.label[b], m.labelEnd[b] = -1, -1
+	m.blossomChilds[b], m.blossomEndps[b] = nil, nil
+	m.blossomBase[b] = -1
+	m.blossomBestEdges[b] = nil
+	m.bestEdge[b] = -1
+	m.unusedBlossoms = append(m.unusedBlossoms, b)
+}
+
+// augmentBlossom swaps matched and unmatched edges along the even path from
+// vertex v to the base of blossom b, rotating b so that v becomes its base.
+func (m *weightedMatcher[W]) augmentBlossom(b int, v int) {
+	t := v
+	for m.blossomParent[t] != b {
+		t = m.blossomParent[t]
+	}
+	if t >= m.n {
+		m.augmentBlossom(t, v)
+	}
+	childs := m.blossomChilds[b]
+	endps := m.blossomEndps[b]
+	i := indexOf(childs, t)
+	j := i
+	var jStep, endpTrick int
+	if i&1 != 0 {
+		j -= len(childs)
+		jStep = 1
+		endpTrick = 0
+	} else {
+		jStep = -1
+		endpTrick = 1
+	}
+	for j != 0 {
+		j += jStep
+		t = at(childs, j)
+		p := at(endps, j-endpTrick) ^ endpTrick
+		if t >= m.n {
+			m.augmentBlossom(t, m.endpoint[p])
+		}
+		j += jStep
+		t = at(childs, j)
+		if t >= m.n {
+			m.augmentBlossom(t, m.endpoint[p^1])
+		}
+		m.mate[m.endpoint[p]] = p ^ 1
+		m.mate[m.endpoint[p^1]] = p
+	}
+	m.blossomChilds[b] = append(append([]int{}, childs[i:]...), childs[:i]...)
+	m.blossomEndps[b] = append(append([]int{}, endps[i:]...), endps[:i]...)
+	m.blossomBase[b] = m.blossomBase[m.blossomChilds[b][0]]
+}
+
+// augmentMatching flips the augmenting path through edge k.
+func (m *weightedMatcher[W]) augmentMatching(k int) {
+	v, w := m.edges[k][0], m.edges[k][1]
+	for _, sp := range [2][2]int{{v, 2*k + 1}, {w, 2 * k}} {
+		s, p := sp[0], sp[1]
+		for {
+			bs := m.inBlossom[s]
+			if bs >= m.n {
+				m.augmentBlossom(bs, s)
+			}
+			m.mate[s] = p
+			if m.labelEnd[bs] == -1 {
+				break
+			}
+			t := m.endpoint[m.labelEnd[bs]]
+			bt := m.inBlossom[t]
+			s = m.endpoint[m.labelEnd[bt]]
+			j := m.endpoint[m.labelEnd[bt]^1]
+			if bt >= m.n {
+				m.augmentBlossom(bt, j)
+			}
+			m.mate[j] = m.labelEnd[bt]
+			p = m.labelEnd[bt] ^ 1
+		}
+	}
+}
+
+// solve runs one stage per possible augmentation and returns the mate of
+// every vertex, or -1 when unmatched.
+func (m *weightedMatcher[W]) solve() []int {
+	n := m.n
+	if len(m.edges) == 0 {
+		return m.mate
+	}
+	for stage := 0; stage < n; stage++ {
+		for i := range m.label {
+			m.label[i] = 0
+			m.bestEdge[i] = -1
+		}
+		for i := n; i < 2*n; i++ {
+			m.blossomBestEdges[i] = nil
+		}
+		for i := range m.allowEdge {
+			m.allowEdge[i] = false
+		}
+		m.queue = m.queue[:0]
+		for v := 0; v < n; v++ {
+			if m.mate[v] == -1 && m.label[m.inBlossom[v]] == 0 {
+				m.assignLabel(v, 1, -1)
+			}
+		}
+
+		augmented := false
+		for {
+			for len(m.queue) > 0 && !augmented {
+				v := m.queue[len(m.queue)-1]
+				m.queue = m.queue[:len(m.queue)-1]
+				for _, p := range m.neighbend[v] {
+					k := p / 2
+					w := m.endpoint[p]
+					if m.inBlossom[v] == m.inBlossom[w] {
+						continue
+					}
+					var kSlack W
+					if !m.allowEdge[k] {
+						kSlack = m.slack(k)
+						if kSlack <= 0 {
+							m.allowEdge[k] = true
+						}
+					}
+					if m.allowEdge[k] {
+						if m.label[m.inBlossom[w]] == 0 {
+							m.assignLabel(w, 2, p^1)
+						} else if m.label[m.inBlossom[w]] == 1 {
+							if base := m.scanBlossom(v, w); base >= 0 {
+								m.addBlossom(base, k)
+							} else {
+								m.augmentMatching(k)
+								augmented = true
+								break
+							}
+						} else if m.label[w] == 0 {
+							m.label[w] = 2
+							m.labelEnd[w] = p ^ 1
+						}
+					} else if m.label[m.inBlossom[w]] == 1 {
+						b := m.inBlossom[v]
+						if m.bestEdge[b] == -1 || kSlack < m.slack(m.bestEdge[b]) {
+							m.bestEdge[b] = k
+						}
+					} else if m.label[w] == 0 {
+						if m.bestEdge[w] == -1 || kSlack < m.slack(m.bestEdge[w]) {
+							m.bestEdge[w] = k
+						}
+					}
+				}
+			}
+			if augmented {
+				break
+			}
+
+			// no augmenting path with the current duals: adjust them
+			deltaType := 1
+			delta := m.dualVar[0]
+			for v := 1; v < n; v++ {
+				delta = min(delta, m.dualVar[v])
+			}
+			deltaEdge, deltaBlossom := -1, -1
+			for v := 0; v < n; v++ {
+				if m.label[m.inBlossom[v]] == 0 && m.bestEdge[v] != -1 {
+					if d := m.slack(m.bestEdge[v]); d < delta {
+						delta = d
+						deltaType = 2
+						deltaEdge = m.bestEdge[v]
+					}
+				}
+			}
+			for b := 0; b < 2*n; b++ {
+				if m.blossomParent[b] == -1 && m.label[b] == 1 && m.bestEdge[b] != -1 {
+					if d := m.slack(m.bestEdge[b]) / 2; d < delta {
+						delta = d
+						deltaType = 3
+						deltaEdge = m.bestEdge[b]
+					}
+				}
+			}
+			for b := n; b < 2*n; b++ {
+				if m.blossomBase[b] >= 0 && m.blossomParent[b] == -1 && m.label[b] == 2 && m.dualVar[b] < delta {
+					delta = m.dualVar[b]
+					deltaType = 4
+					deltaBlossom = b
+				}
+			}
+
+			for v := 0; v < n; v++ {
+				switch m.label[m.inBlossom[v]] {
+				case 1:
+					m.dualVar[v] -= delta
+				case 2:
+					m.dualVar[v] += delta
+				}
+			}
+			for b := n; b < 2*n; b++ {
+				if m.blossomBase[b] >= 0 && m.blossomParent[b] == -1 {
+					switch m.label[b] {
+					case 1:
+						m.dualVar[b] += delta
+					case 2:
+						m.dualVar[b] -= delta
+					}
+				}
+			}
+
+			if deltaType == 1 {
+				break
+			} else if deltaType == 2 {
+				m.allowEdge[deltaEdge] = true
+				i, j := m.edges[deltaEdge][0], m.edges[deltaEdge][1]
+				if m.label[m.inBlossom[i]] == 0 {
+					i = j
+				}
+				m.queue = append(m.queue, i)
+			} else if deltaType == 3 {
+				m.allowEdge[deltaEdge] = true
+				m.queue = append(m.queue, m.edges[deltaEdge][0])
+			} else {
+				m.expandBlossom(deltaBlossom, false)
+			}
+		}
+		if !augmented {
+			break
+		}
+		for b := n; b < 2*n; b++ {
+			if m.blossomParent[b] == -1 && m.blossomBase[b] >= 0 && m.label[b] == 1 && m.dualVar[b] == 0 {
+				m.expandBlossom(b, true)
+			}
+		}
+	}
+
+	mate := make([]int, n)
+	for v := 0; v < n; v++ {
+		mate[v] = -1
+		if m.mate[v] >= 0 {
+			mate[v] = m.endpoint[m.mate[v]]
+		}
+	}
+	return mate
+}
+
+func reverseInts(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bruteForceMatching returns the largest matching size and the heaviest
+// matching weight of edges by trying every subset.
+func bruteForceMatching(edges []weightedEdgeCase) (int, int) {
+	bestSize, bestWeight := 0, 0
+	used := map[string]bool{}
+	var search func(i, size, weight int)
+	search = func(i, size, weight int) {
+		bestSize, bestWeight = max(bestSize, size), max(bestWeight, weight)
+		for ; i < len(edges); i++ {
+			e := edges[i]
+			if e.from == e.to || used[e.from] || used[e.to] {
+				continue
+			}
+			used[e.from], used[e.to] = true, true
+			search(i+1, size+1, weight+max(e.weight, 0))
+			used[e.from], used[e.to] = false, false
+		}
+	}
+	search(0, 0, 0)
+	return bestSize, bestWeight
+}
+
+func TestMaximumMatching(t *testing.T) {
+	petersen := [][2]string{
+		{"0", "1"}, {"1", "2"}, {"2", "3"}, {"3", "4"}, {"4", "0"},
+		{"0", "5"}, {"1", "6"}, {"2", "7"}, {"3", "8"}, {"4", "9"},
+		{"5", "7"}, {"7", "9"}, {"9", "6"}, {"6", "8"}, {"8", "5"},
+	}
+	tests := []struct {
+		name  string
+		edges [][2]string
+		size  int
+	}{
+		// Matching b-c first leaves a and d exposed unless the search
+		// shrinks the triangle a-b-c.
+		{"triangle with tails", [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}, {"a", "e"}}, 2},
+		{"two pentagons joined", [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "e"}, {"e", "a"}, {"e", "f"}, {"f", "g"}, {"g", "h"}, {"h", "i"}, {"i", "j"}, {"j", "f"}}, 5},
+		{"petersen", petersen, 5},
+		{"self-loop only", [][2]string{{"a", "a"}}, 0},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := buildGraph(Undirected, repType, tt.edges)
+			checkMatching[string](t, tt.name, g, g.MaximumMatching(), nil, tt.size)
+		}
+	}
+}
+
+func TestMaximumMatchingRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	names := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"}
+	for round := 0; round < 200; round++ {
+		edges := []weightedEdgeCase{}
+		seen := map[[2]string]bool{}
+		for len(edges) < 4+rng.Intn(9) {
+			from, to := names[rng.Intn(len(names))], names[rng.Intn(len(names))]
+			if from == to || seen[[2]string{from, to}] || seen[[2]string{to, from}] {
+				continue
+			}
+			seen[[2]string{from, to}] = true
+			edges = append(edges, weightedEdgeCase{from, to, rng.Intn(20) - 3})
+		}
+		size, weight := bruteForceMatching(edges)
+		repType := representations[round%len(representations)]
+		g := buildWeighted(Undirected, repType, edges)
+
+		checkMatching[string](t, "random", g, g.MaximumMatching(), nil, size)
+
+		pairs, total := g.MaximumWeightMatching()
+		if total != weight {
+			t.Errorf("round %d: MaximumWeightMatching weighs %d, want %d for %v", round, total, weight, edges)
+		}
+		sum := 0
+		for _, p := range pairs {
+			w, _ := g.Weight(p[0], p[1])
+			if w <= 0 {
+				t.Errorf("round %d: pair %v weighs %d", round, p, w)
+			}
+			sum += w
+		}
+		if sum != total {
+			t.Errorf("round %d: pairs %v weigh %d, reported %d", round, pairs, sum, total)
+		}
+		checkMatching[string](t, "random weighted", g, pairs, nil, len(pairs))
+	}
+}
+
+func TestMaximumWeightMatching(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges []weightedEdgeCase
+		total int
+		pairs int
+	}{
+		{"heavy middle beats two ends", []weightedEdgeCase{{"a", "b", 3}, {"b", "c", 10}, {"c", "d", 3}}, 10, 1},
+		{"two ends beat light middle", []weightedEdgeCase{{"a", "b", 6}, {"b", "c", 10}, {"c", "d", 6}}, 12, 2},
+		{"non-positive edges unused", []weightedEdgeCase{{"a", "b", -4}, {"c", "d", 0}, {"d", "e", -1}}, 0, 0},
+		{"blossom", []weightedEdgeCase{{"a", "b", 8}, {"b", "c", 9}, {"c", "a", 10}, {"c", "d", 7}, {"a", "e", 6}, {"e", "f", 5}}, 20, 3},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := buildWeighted(Undirected, repType, tt.edges)
+			pairs, total := g.MaximumWeightMatching()
+			if total != tt.total || len(pairs) != tt.pairs {
+				t.Errorf("%s/%v: got %v weighing %d, want %d pairs weighing %d", tt.name, repType, pairs, total, tt.pairs, tt.total)
+			}
+		}
+	}
+}
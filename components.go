@@ -0,0 +1,155 @@
+package graph
+
+func (g *Graph[T]) ConnectedComponents() ([][]T, map[T]int) {
+	return ConnectedComponents[T](g)
+}
+
+func (g *Graph[T]) WeaklyConnectedComponents() ([][]T, map[T]int) {
+	return WeaklyConnectedComponents[T](g)
+}
+
+func (g *Graph[T]) IsBipartite() (bool, map[T]int, []T) {
+	return IsBipartite[T](g)
+}
+
+func (g *WeightedGraph[T, W]) ConnectedComponents() ([][]T, map[T]int) {
+	return ConnectedComponents[T](g)
+}
+
+func (g *WeightedGraph[T, W]) WeaklyConnectedComponents() ([][]T, map[T]int) {
+	return WeaklyConnectedComponents[T](g)
+}
+
+func (g *WeightedGraph[T, W]) IsBipartite() (bool, map[T]int, []T) {
+	return IsBipartite[T](g)
+}
+
+// ConnectedComponents groups the nodes into connected components and maps
+// every node to the index of its component. For directed graphs edge
+// direction is ignored, as in WeaklyConnectedComponents.
+func ConnectedComponents[T comparable](g Traversable[T]) ([][]T, map[T]int) {
+	if g.IsDirected() {
+		return WeaklyConnectedComponents(g)
+	}
+	return connectedComponents(g.Nodes(), g.Neighbours)
+}
+
+// WeaklyConnectedComponents groups the nodes of a directed graph into
+// components that are connected when edge direction is ignored.
+func WeaklyConnectedComponents[T comparable](g Traversable[T]) ([][]T, map[T]int) {
+	nodes := g.Nodes()
+	return connectedComponents(nodes, ignoreDirection(nodes, g.Neighbours))
+}
+
+// IsBipartite reports whether the nodes can be two-coloured so that every
+// edge joins different colours, ignoring edge direction. On success it
+// returns the colour (0 or 1) of every node; otherwise it returns an odd
+// cycle as a witness.
+func IsBipartite[T comparable](g Traversable[T]) (bool, map[T]int, []T) {
+	nodes := g.Nodes()
+	if g.IsDirected() {
+		return twoColour(nodes, ignoreDirection(nodes, g.Neighbours))
+	}
+	return twoColour(nodes, g.Neighbours)
+}
+
+// ignoreDirection returns a neighbour function that follows edges both ways.
+func ignoreDirection[T comparable](nodes []T, neighbours func(T) []T) func(T) []T {
+	adj := make(map[T]map[T]struct{}, len(nodes))
+	for _, node := range nodes {
+		adj[node] = map[T]struct{}{}
+	}
+	for _, node := range nodes {
+		for _, nbr := range neighbours(node) {
+			adj[node][nbr] = struct{}{}
+			adj[nbr][node] = struct{}{}
+		}
+	}
+	return func(node T) []T {
+		nbrs := make([]T, 0, len(adj[node]))
+		for nbr := range adj[node] {
+			nbrs = append(nbrs, nbr)
+		}
+		return nbrs
+	}
+}
+
+func connectedComponents[T comparable](nodes []T, neighbours func(T) []T) ([][]T, map[T]int) {
+	components := [][]T{}
+	componentOf := make(map[T]int, len(nodes))
+	for _, root := range nodes {
+		if _, seen := componentOf[root]; seen {
+			continue
+		}
+		id := len(components)
+		componentOf[root] = id
+		component := []T{}
+		queue := []T{root}
+		for len(queue) > 0 {
+			curr := queue[0]
+			queue = queue[1:]
+			component = append(component, curr)
+			for _, nbr := range neighbours(curr) {
+				if _, seen := componentOf[nbr]; !seen {
+					componentOf[nbr] = id
+					queue = append(queue, nbr)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components, componentOf
+}
+
+// twoColour BFS-colours every component. When an edge joins two nodes of the
+// same colour, the tree paths from both ends up to their common ancestor form
+// an odd cycle.
+func twoColour[T comparable](nodes []T, neighbours func(T) []T) (bool, map[T]int, []T) {
+	colour := make(map[T]int, len(nodes))
+	parent := map[T]T{}
+	depth := map[T]int{}
+	for _, root := range nodes {
+		if _, seen := colour[root]; seen {
+			continue
+		}
+		colour[root] = 0
+		depth[root] = 0
+		queue := []T{root}
+		for len(queue) > 0 {
+			curr := queue[0]
+			queue = queue[1:]
+			for _, nbr := range neighbours(curr) {
+				c, seen := colour[nbr]
+				if !seen {
+					colour[nbr] = 1 - colour[curr]
+					parent[nbr] = curr
+					depth[nbr] = depth[curr] + 1
+					queue = append(queue, nbr)
+				} else if c == colour[curr] {
+					return false, nil, oddCycle(parent, depth, curr, nbr)
+				}
+			}
+		}
+	}
+	return true, colour, nil
+}
+
+func oddCycle[T comparable](parent map[T]T, depth map[T]int, u T, v T) []T {
+	left := []T{u}
+	right := []T{v}
+	for u != v {
+		if depth[u] >= depth[v] {
+			u = parent[u]
+			left = append(left, u)
+		} else {
+			v = parent[v]
+			right = append(right, v)
+		}
+	}
+	// both paths now end at the common ancestor
+	cycle := left
+	for i := len(right) - 2; i >= 0; i-- {
+		cycle = append(cycle, right[i])
+	}
+	return cycle
+}
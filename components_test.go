@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestConnectedComponents(t *testing.T) {
+	edges := [][2]string{{"a", "b"}, {"c", "b"}, {"d", "e"}, {"f", "f"}}
+	want := []string{"abc", "de", "f", "g"}
+	for _, graphType := range []GraphType{Directed, Undirected} {
+		for _, repType := range representations {
+			g := buildGraph(graphType, repType, edges)
+			g.AddNode("g")
+			components, componentOf := g.ConnectedComponents()
+			if got := normalizeComponents(components); !slices.Equal(got, want) {
+				t.Errorf("%v/%v: components = %v, want %v", graphType, repType, got, want)
+			}
+			for i, component := range components {
+				for _, node := range component {
+					if componentOf[node] != i {
+						t.Errorf("%v/%v: componentOf[%s] = %d, want %d", graphType, repType, node, componentOf[node], i)
+					}
+				}
+			}
+			if graphType == Directed {
+				weak, _ := g.WeaklyConnectedComponents()
+				if got := normalizeComponents(weak); !slices.Equal(got, want) {
+					t.Errorf("%v: weak components = %v, want %v", repType, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestIsBipartite(t *testing.T) {
+	tests := []struct {
+		name      string
+		edges     [][2]string
+		bipartite bool
+	}{
+		{"even cycle", [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "a"}}, true},
+		{"tree", [][2]string{{"a", "b"}, {"a", "c"}, {"c", "d"}, {"c", "e"}}, true},
+		{"pentagon", [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "e"}, {"e", "a"}}, false},
+		{"triangle off a square", [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "a"}, {"c", "x"}, {"x", "y"}, {"y", "c"}}, false},
+		{"self-loop", [][2]string{{"a", "b"}, {"b", "b"}}, false},
+	}
+	for _, tt := range tests {
+		for _, graphType := range []GraphType{Directed, Undirected} {
+			for _, repType := range representations {
+				g := buildGraph(graphType, repType, tt.edges)
+				ok, colour, cycle := g.IsBipartite()
+				if ok != tt.bipartite {
+					t.Fatalf("%s/%v/%v: IsBipartite = %v", tt.name, graphType, repType, ok)
+				}
+				if ok {
+					for _, e := range g.Edges() {
+						if colour[e[0]] == colour[e[1]] {
+							t.Errorf("%s/%v/%v: edge %v joins colour %d", tt.name, graphType, repType, e, colour[e[0]])
+						}
+					}
+					continue
+				}
+				if len(cycle)%2 != 1 {
+					t.Errorf("%s/%v/%v: witness %v is not an odd cycle", tt.name, graphType, repType, cycle)
+				}
+				for i, from := range cycle {
+					to := cycle[(i+1)%len(cycle)]
+					if !g.HasEdge(from, to) && !g.HasEdge(to, from) {
+						t.Errorf("%s/%v/%v: witness %v uses missing edge %s - %s", tt.name, graphType, repType, cycle, from, to)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,419 @@
+package graph
+
+import "sync"
+
+// ConcurrentGraph is a Graph that is safe for use by several goroutines.
+// Writes take an exclusive lock and reads a shared one, so every method sees
+// the graph between two writes.
+//
+// It wraps only the core methods: adding and removing nodes and edges,
+// ConvertTo, the queries on nodes, edges and degrees, and the basic
+// traversals. Everything else in the package works on a graph handed out by
+// View, Update or Snapshot. View runs package functions such as
+// DepthFirstSearch or StronglyConnectedComponents under the read lock, Update
+// makes several changes at once, and a Snapshot suits long-running work
+// because it does not block writers once it is made.
+type ConcurrentGraph[T comparable] struct {
+	mu sync.RWMutex
+	g  *Graph[T]
+}
+
+// GraphView is the read-only access View gives to a ConcurrentGraph: it can
+// be passed to the package functions taking a Traversable and to NodeAttr
+// and EdgeAttr, but has no methods that change the graph.
+type GraphView[T comparable] interface {
+	Traversable[T]
+	Attributed[T]
+}
+
+// WeightedGraphView is the WeightedTraversable counterpart of GraphView.
+type WeightedGraphView[T comparable, W Number] interface {
+	WeightedTraversable[T, W]
+	Attributed[T]
+}
+
+// graphView implements GraphView over a graph, hiding the graph's other
+// methods from type assertions.
+type graphView[T comparable] struct {
+	g interface {
+		Traversable[T]
+		Attributed[T]
+	}
+}
+
+func (v graphView[T]) Nodes() []T {
+	return v.g.Nodes()
+}
+
+func (v graphView[T]) HasNode(node T) bool {
+	return v.g.HasNode(node)
+}
+
+func (v graphView[T]) HasEdge(from T, to T) bool {
+	return v.g.HasEdge(from, to)
+}
+
+func (v graphView[T]) Neighbours(node T) []T {
+	return v.g.Neighbours(node)
+}
+
+func (v graphView[T]) IsDirected() bool {
+	return v.g.IsDirected()
+}
+
+func (v graphView[T]) NodeAttrs(node T) map[string]any {
+	return v.g.NodeAttrs(node)
+}
+
+func (v graphView[T]) EdgeAttrs(from T, to T) map[string]any {
+	return v.g.EdgeAttrs(from, to)
+}
+
+func (v graphView[T]) attributeStore() *attributes[T] {
+	return v.g.attributeStore()
+}
+
+// weightedGraphView implements WeightedGraphView.
+type weightedGraphView[T comparable, W Number] struct {
+	graphView[T]
+	weighted *WeightedGraph[T, W]
+}
+
+func (v weightedGraphView[T, W]) Weight(from T, to T) (W, bool) {
+	return v.weighted.Weight(from, to)
+}
+
+func NewConcurrentGraph[T comparable](graphType GraphType, repType RepresentationType) *ConcurrentGraph[T] {
+	return &ConcurrentGraph[T]{g: NewGraph[T](graphType, repType)}
+}
+
+// Snapshot returns a copy-on-write view of the graph as it is now. The view
+// is a plain Graph owned by the caller, so every algorithm in the package can
+// run on it without holding any lock.
+func (c *ConcurrentGraph[T]) Snapshot() *Graph[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.g.Snapshot()
+}
+
+// View calls fn with a read-only view of the graph under the read lock. fn
+// must not keep the view after returning.
+func (c *ConcurrentGraph[T]) View(fn func(g GraphView[T])) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	fn(graphView[T]{c.g})
+}
+
+// Update calls fn with the graph under the write lock, making its changes
+// visible to other goroutines all at once. fn must not keep the graph after
+// returning.
+func (c *ConcurrentGraph[T]) Update(fn func(g *Graph[T])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn(c.g)
+}
+
+func (c *ConcurrentGraph[T]) AddNode(node T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.g.AddNode(node)
+}
+
+func (c *ConcurrentGraph[T]) RemoveNode(node T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.g.RemoveNode(node)
+}
+
+func (c *ConcurrentGraph[T]) RemoveNodeChecked(node T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.g.RemoveNodeChecked(node)
+}
+
+func (c *ConcurrentGraph[T]) AddEdge(from T, to T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.g.AddEdge(from, to)
+}
+
+func (c *ConcurrentGraph[T]) RemoveEdge(from T, to T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.g.RemoveEdge(from, to)
+}
+
+func (c *ConcurrentGraph[T]) RemoveEdgeChecked(from T, to T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.g.RemoveEdgeChecked(from, to)
+}
+
+func (c *ConcurrentGraph[T]) ConvertTo(repType RepresentationType) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.g.ConvertTo(repType)
+}
+
+func (c *ConcurrentGraph[T]) IsDirected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.IsDirected()
+}
+
+func (c *ConcurrentGraph[T]) Representation() RepresentationType {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Representation()
+}
+
+func (c *ConcurrentGraph[T]) HasNode(node T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.HasNode(node)
+}
+
+func (c *ConcurrentGraph[T]) HasEdge(from T, to T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.HasEdge(from, to)
+}
+
+func (c *ConcurrentGraph[T]) Neighbours(node T) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Neighbours(node)
+}
+
+func (c *ConcurrentGraph[T]) Nodes() []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Nodes()
+}
+
+func (c *ConcurrentGraph[T]) Edges() [][2]T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Edges()
+}
+
+func (c *ConcurrentGraph[T]) OutDegree(node T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.OutDegree(node)
+}
+
+func (c *ConcurrentGraph[T]) InDegree(node T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.InDegree(node)
+}
+
+func (c *ConcurrentGraph[T]) Degree(node T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Degree(node)
+}
+
+func (c *ConcurrentGraph[T]) NodeAttrs(node T) map[string]any {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.NodeAttrs(node)
+}
+
+func (c *ConcurrentGraph[T]) EdgeAttrs(from T, to T) map[string]any {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.EdgeAttrs(from, to)
+}
+
+func (c *ConcurrentGraph[T]) BFS(start T) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.BFS(start)
+}
+
+func (c *ConcurrentGraph[T]) DFSIterative(start T) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.DFSIterative(start)
+}
+
+func (c *ConcurrentGraph[T]) BFSShortestPath(source T, target T) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.BFSShortestPath(source, target)
+}
+
+// ConcurrentWeightedGraph is the WeightedGraph counterpart of
+// ConcurrentGraph.
+type ConcurrentWeightedGraph[T comparable, W Number] struct {
+	mu sync.RWMutex
+	g  *WeightedGraph[T, W]
+}
+
+func NewConcurrentWeightedGraph[T comparable, W Number](graphType GraphType, repType RepresentationType) *ConcurrentWeightedGraph[T, W] {
+	return &ConcurrentWeightedGraph[T, W]{g: NewWeightedGraph[T, W](graphType, repType)}
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) Snapshot() *WeightedGraph[T, W] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.g.Snapshot()
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) View(fn func(g WeightedGraphView[T, W])) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	fn(weightedGraphView[T, W]{graphView[T]{c.g}, c.g})
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) Update(fn func(g *WeightedGraph[T, W])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn(c.g)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) AddNode(node T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.g.AddNode(node)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) RemoveNode(node T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.g.RemoveNode(node)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) RemoveNodeChecked(node T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.g.RemoveNodeChecked(node)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) AddEdge(from T, to T, weight W) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.g.AddEdge(from, to, weight)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) RemoveEdge(from T, to T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.g.RemoveEdge(from, to)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) RemoveEdgeChecked(from T, to T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.g.RemoveEdgeChecked(from, to)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) ConvertTo(repType RepresentationType) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.g.ConvertTo(repType)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) IsDirected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.IsDirected()
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) Representation() RepresentationType {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Representation()
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) HasNode(node T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.HasNode(node)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) HasEdge(from T, to T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.HasEdge(from, to)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) Weight(from T, to T) (W, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Weight(from, to)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) Neighbours(node T) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Neighbours(node)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) Nodes() []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Nodes()
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) Edges() []WeightedEdge[T, W] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Edges()
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) OutDegree(node T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.OutDegree(node)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) InDegree(node T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.InDegree(node)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) Degree(node T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.Degree(node)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) NodeAttrs(node T) map[string]any {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.NodeAttrs(node)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) EdgeAttrs(from T, to T) map[string]any {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.EdgeAttrs(from, to)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) BFS(start T) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.BFS(start)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) DFSIterative(start T) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.DFSIterative(start)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) BFSShortestPath(source T, target T) []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.BFSShortestPath(source, target)
+}
+
+func (c *ConcurrentWeightedGraph[T, W]) DijkstraShortestPath(source T, target T) ([]T, W) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.g.DijkstraShortestPath(source, target)
+}
@@ -0,0 +1,178 @@
+package graph
+
+import (
+	"sync"
+	"testing"
+)
+
+// Run these with go test -race: they only fail without it if the wrappers
+// let a reader see a graph in the middle of a write.
+
+const (
+	concurrentWriters = 4
+	concurrentReaders = 4
+	concurrentRounds  = 200
+	concurrentNodes   = 16
+)
+
+func TestConcurrentGraph(t *testing.T) {
+	for _, graphType := range []GraphType{Directed, Undirected} {
+		for _, repType := range representations {
+			c := NewConcurrentGraph[int](graphType, repType)
+			var wg sync.WaitGroup
+			for w := 0; w < concurrentWriters; w++ {
+				wg.Add(1)
+				go func(w int) {
+					defer wg.Done()
+					for i := 0; i < concurrentRounds; i++ {
+						from, to := (w+i)%concurrentNodes, (w*i+1)%concurrentNodes
+						c.AddEdge(from, to)
+						if i%7 == 0 {
+							c.RemoveNode((from + 3) % concurrentNodes)
+						}
+						if i%5 == 0 {
+							c.RemoveEdge(to, from)
+						}
+						if i%50 == 0 {
+							c.Update(func(g *Graph[int]) {
+								g.AddEdge(from, from)
+								g.RemoveEdge(from, from)
+							})
+						}
+					}
+				}(w)
+			}
+			for r := 0; r < concurrentReaders; r++ {
+				wg.Add(1)
+				go func(r int) {
+					defer wg.Done()
+					for i := 0; i < concurrentRounds; i++ {
+						start := (r + i) % concurrentNodes
+						c.BFS(start)
+						c.DFSIterative(start)
+						c.BFSShortestPath(start, (start+5)%concurrentNodes)
+						c.View(func(g GraphView[int]) {
+							if _, ok := g.(*Graph[int]); ok {
+								t.Errorf("View handed out the mutable graph")
+							}
+							DepthFirstSearch[int](g, VisitorFuncs[int]{
+								OnDiscoverNode: func(node int) VisitAction {
+									if !g.HasNode(node) {
+										t.Errorf("View: search reached missing node %d", node)
+									}
+									return Continue
+								},
+							})
+							HasCycle[int](g)
+						})
+						s := c.Snapshot()
+						checkGraphConsistent(t, s)
+						ConnectedComponents[int](s)
+						s.AddNode(-1)
+					}
+				}(r)
+			}
+			wg.Wait()
+			s := c.Snapshot()
+			checkGraphConsistent(t, s)
+			if s.HasNode(-1) {
+				t.Errorf("%v/%v: a snapshot change reached the live graph", graphType, repType)
+			}
+		}
+	}
+}
+
+func TestConcurrentWeightedGraph(t *testing.T) {
+	for _, graphType := range []GraphType{Directed, Undirected} {
+		for _, repType := range representations {
+			c := NewConcurrentWeightedGraph[int, float64](graphType, repType)
+			var wg sync.WaitGroup
+			for w := 0; w < concurrentWriters; w++ {
+				wg.Add(1)
+				go func(w int) {
+					defer wg.Done()
+					for i := 0; i < concurrentRounds; i++ {
+						from, to := (w+i)%concurrentNodes, (w*i+1)%concurrentNodes
+						c.AddEdge(from, to, float64(i%9)+0.5)
+						if i%7 == 0 {
+							c.RemoveNode((from + 3) % concurrentNodes)
+						}
+						if i%5 == 0 {
+							c.RemoveEdge(to, from)
+						}
+						if i%40 == 0 {
+							c.Update(func(g *WeightedGraph[int, float64]) { SetNodeAttr(g, from, "seen", i) })
+						}
+					}
+				}(w)
+			}
+			for r := 0; r < concurrentReaders; r++ {
+				wg.Add(1)
+				go func(r int) {
+					defer wg.Done()
+					for i := 0; i < concurrentRounds; i++ {
+						start := (r + i) % concurrentNodes
+						c.BFS(start)
+						c.DijkstraShortestPath(start, (start+3)%concurrentNodes)
+						c.NodeAttrs(start)
+						c.View(func(g WeightedGraphView[int, float64]) {
+							for _, e := range edgesOf[int, float64](g) {
+								if w, ok := g.Weight(e.Edge[0], e.Edge[1]); !ok || w != e.Weight {
+									t.Errorf("View: edge %v weighs %v, %v; listed as %v", e.Edge, w, ok, e.Weight)
+								}
+							}
+							NodeAttr[int](g, start, "seen")
+						})
+						s := c.Snapshot()
+						before := len(s.Edges())
+						Dijkstra[int, float64](s, start)
+						s.Kruskal()
+						if after := len(s.Edges()); after != before {
+							t.Errorf("snapshot changed from %d to %d edges", before, after)
+						}
+					}
+				}(r)
+			}
+			wg.Wait()
+			s := c.Snapshot()
+			for _, e := range s.Edges() {
+				if !s.HasNode(e.Edge[0]) || !s.HasNode(e.Edge[1]) {
+					t.Errorf("%v/%v: edge %v has a missing end", graphType, repType, e.Edge)
+				}
+			}
+		}
+	}
+}
+
+func TestConcurrentSnapshotIsolation(t *testing.T) {
+	for _, repType := range representations {
+		c := NewConcurrentGraph[int](Directed, repType)
+		c.AddEdge(1, 2)
+		s := c.Snapshot()
+		c.AddEdge(2, 3)
+		c.RemoveNode(1)
+		if !s.HasEdge(1, 2) || s.HasNode(3) {
+			t.Errorf("%v: snapshot sees later writes", repType)
+		}
+		if c.HasNode(1) || !c.HasEdge(2, 3) {
+			t.Errorf("%v: live graph lost writes", repType)
+		}
+	}
+}
+
+// checkGraphConsistent fails t if g has an edge to a node it does not have.
+func checkGraphConsistent(t *testing.T, g *Graph[int]) {
+	t.Helper()
+	for _, e := range g.Edges() {
+		if !g.HasNode(e[0]) || !g.HasNode(e[1]) {
+			t.Errorf("edge %v has a missing end", e)
+		}
+	}
+	for _, node := range g.Nodes() {
+		for _, nbr := range g.Neighbours(node) {
+			if !g.HasNode(nbr) {
+				t.Errorf("%d has missing neighbour %d", node, nbr)
+			}
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package graph
+
+func (g *Graph[T]) Bridges() [][2]T {
+	return Bridges[T](g)
+}
+
+func (g *Graph[T]) ArticulationPoints() []T {
+	return ArticulationPoints[T](g)
+}
+
+func (g *Graph[T]) BiconnectedComponents() [][]T {
+	return BiconnectedComponents[T](g)
+}
+
+func (g *Graph[T]) TwoEdgeConnectedComponents() [][]T {
+	return TwoEdgeConnectedComponents[T](g)
+}
+
+func (g *WeightedGraph[T, W]) Bridges() [][2]T {
+	return Bridges[T](g)
+}
+
+func (g *WeightedGraph[T, W]) ArticulationPoints() []T {
+	return ArticulationPoints[T](g)
+}
+
+func (g *WeightedGraph[T, W]) BiconnectedComponents() [][]T {
+	return BiconnectedComponents[T](g)
+}
+
+func (g *WeightedGraph[T, W]) TwoEdgeConnectedComponents() [][]T {
+	return TwoEdgeConnectedComponents[T](g)
+}
+
+// Bridges returns the edges of an undirected graph whose removal increases
+// the number of connected components. In a Multigraph an edge with a parallel
+// twin is never a bridge.
+//
+// Bridges, ArticulationPoints, BiconnectedComponents and
+// TwoEdgeConnectedComponents all treat a directed graph as undirected, as
+// Kruskal does: every edge can be followed either way, and two opposite edges
+// between the same nodes count as parallel edges, so neither is a bridge.
+// Bridges of a directed graph are reported in the direction of their edge.
+func Bridges[T comparable](g Traversable[T]) [][2]T {
+	bridges := lowLink(g.Nodes(), undirectedNeighbours(g)).bridges
+	if g.IsDirected() {
+		for i, b := range bridges {
+			if !g.HasEdge(b[0], b[1]) {
+				bridges[i] = [2]T{b[1], b[0]}
+			}
+		}
+	}
+	return bridges
+}
+
+// ArticulationPoints returns the nodes of an undirected graph whose removal
+// increases the number of connected components.
+func ArticulationPoints[T comparable](g Traversable[T]) []T {
+	return lowLink(g.Nodes(), undirectedNeighbours(g)).articulationPoints
+}
+
+// BiconnectedComponents returns the node sets of the maximal 2-vertex
+// connected subgraphs (blocks) of an undirected graph. Articulation points
+// appear in several blocks; isolated nodes appear in none.
+func BiconnectedComponents[T comparable](g Traversable[T]) [][]T {
+	return lowLink(g.Nodes(), undirectedNeighbours(g)).blocks
+}
+
+// TwoEdgeConnectedComponents partitions the nodes of an undirected graph into
+// the components left after removing every bridge.
+func TwoEdgeConnectedComponents[T comparable](g Traversable[T]) [][]T {
+	return twoEdgeConnectedComponents(g.Nodes(), undirectedNeighbours(g))
+}
+
+// undirectedNeighbours returns the neighbour function of g seen as an
+// undirected multigraph: each edge of a directed graph is listed from both
+// ends, once per edge, and undirected graphs are left as they are.
+func undirectedNeighbours[T comparable](g Traversable[T]) func(T) []T {
+	neighbours := edgeNeighboursOf(g)
+	if !g.IsDirected() {
+		return neighbours
+	}
+	adj := map[T][]T{}
+	for _, from := range g.Nodes() {
+		for _, to := range neighbours(from) {
+			adj[from] = append(adj[from], to)
+			if from != to {
+				adj[to] = append(adj[to], from)
+			}
+		}
+	}
+	return func(node T) []T {
+		return adj[node]
+	}
+}
+
+type lowLinkResult[T comparable] struct {
+	bridges            [][2]T
+	articulationPoints []T
+	blocks             [][]T
+}
+
+// lowLink runs Tarjan's low-link algorithm as an undirected DepthFirstSearch,
+// collecting bridges, articulation points and blocks in one pass.
+func lowLink[T comparable](nodes []T, neighbours func(T) []T) lowLinkResult[T] {
+	result := lowLinkResult[T]{
+		bridges:            [][2]T{},
+		articulationPoints: []T{},
+		blocks:             [][]T{},
+	}
+	disc := map[T]int{}
+	low := map[T]int{}
+	parent := map[T]T{}
+	children := map[T]int{}
+	isArticulation := map[T]struct{}{}
+	edgeStack := [][2]T{}
+	time := 0
+
+	depthFirstSearch(nodes, neighbours, false, VisitorFuncs[T]{
+		OnDiscoverNode: func(node T) VisitAction {
+			disc[node] = time
+			low[node] = time
+			time++
+			return Continue
+		},
+		OnTreeEdge: func(from T, to T) VisitAction {
+			parent[to] = from
+			children[from]++
+			edgeStack = append(edgeStack, [2]T{from, to})
+			return Continue
+		},
+		OnBackEdge: func(from T, to T) VisitAction {
+			if from != to {
+				low[from] = min(low[from], disc[to])
+				edgeStack = append(edgeStack, [2]T{from, to})
+			}
+			return Continue
+		},
+		OnFinishNode: func(node T) VisitAction {
+			p, hasParent := parent[node]
+			if !hasParent {
+				if children[node] > 1 {
+					isArticulation[node] = struct{}{}
+				}
+				return Continue
+			}
+			low[p] = min(low[p], low[node])
+			if low[node] > disc[p] {
+				result.bridges = append(result.bridges, [2]T{p, node})
+			}
+			if low[node] >= disc[p] {
+				if _, pHasParent := parent[p]; pHasParent {
+					isArticulation[p] = struct{}{}
+				}
+				inBlock := map[T]struct{}{}
+				block := []T{}
+				for {
+					e := edgeStack[len(edgeStack)-1]
+					edgeStack = edgeStack[:len(edgeStack)-1]
+					for _, n := range e {
+						if _, ok := inBlock[n]; !ok {
+							inBlock[n] = struct{}{}
+							block = append(block, n)
+						}
+					}
+					if e[0] == p && e[1] == node {
+						break
+					}
+				}
+				result.blocks = append(result.blocks, block)
+			}
+			return Continue
+		},
+	})
+	for _, node := range nodes {
+		if _, ok := isArticulation[node]; ok {
+			result.articulationPoints = append(result.articulationPoints, node)
+		}
+	}
+	return result
+}
+
+func twoEdgeConnectedComponents[T comparable](nodes []T, neighbours func(T) []T) [][]T {
+	bridge := map[[2]T]struct{}{}
+	for _, e := range lowLink(nodes, neighbours).bridges {
+		bridge[e] = struct{}{}
+		bridge[[2]T{e[1], e[0]}] = struct{}{}
+	}
+	visited := map[T]struct{}{}
+	components := [][]T{}
+	for _, root := range nodes {
+		if _, seen := visited[root]; seen {
+			continue
+		}
+		visited[root] = struct{}{}
+		component := []T{}
+		stack := []T{root}
+		for len(stack) > 0 {
+			curr := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			component = append(component, curr)
+			for _, nbr := range neighbours(curr) {
+				if _, isBridge := bridge[[2]T{curr, nbr}]; isBridge {
+					continue
+				}
+				if _, seen := visited[nbr]; !seen {
+					visited[nbr] = struct{}{}
+					stack = append(stack, nbr)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
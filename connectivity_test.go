@@ -0,0 +1,115 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+// bowtie is two triangles sharing c, with the tail e-f-g hanging off the
+// second triangle.
+var bowtie = [][2]string{
+	{"a", "b"}, {"b", "c"}, {"c", "a"},
+	{"c", "d"}, {"d", "e"}, {"e", "c"},
+	{"e", "f"}, {"f", "g"},
+}
+
+func TestLowLink(t *testing.T) {
+	tests := []struct {
+		name         string
+		edges        [][2]string
+		bridges      []string
+		articulation []string
+		blocks       []string
+		twoEdged     []string
+	}{
+		{
+			name:         "bowtie with tail",
+			edges:        bowtie,
+			bridges:      []string{"ef", "fg"},
+			articulation: []string{"c", "e", "f"},
+			blocks:       []string{"abc", "cde", "ef", "fg"},
+			twoEdged:     []string{"abcde", "f", "g", "h"},
+		},
+		{
+			name:         "cycle",
+			edges:        [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "a"}},
+			blocks:       []string{"abcd"},
+			twoEdged:     []string{"abcd", "h"},
+			bridges:      []string{},
+			articulation: []string{},
+		},
+		{
+			name:         "star",
+			edges:        [][2]string{{"x", "a"}, {"x", "b"}, {"x", "c"}},
+			bridges:      []string{"ax", "bx", "cx"},
+			articulation: []string{"x"},
+			blocks:       []string{"ax", "bx", "cx"},
+			twoEdged:     []string{"a", "b", "c", "h", "x"},
+		},
+	}
+	for _, tt := range tests {
+		for _, graphType := range []GraphType{Directed, Undirected} {
+			for _, repType := range representations {
+				g := buildGraph(graphType, repType, tt.edges)
+				g.AddNode("h")
+
+				bridges := []string{}
+				for _, b := range g.Bridges() {
+					if !g.HasEdge(b[0], b[1]) {
+						t.Errorf("%s/%v/%v: bridge %v is not an edge", tt.name, graphType, repType, b)
+					}
+					bridges = append(bridges, normalizeComponents([][]string{b[:]})...)
+				}
+				slices.Sort(bridges)
+				if !slices.Equal(bridges, tt.bridges) {
+					t.Errorf("%s/%v/%v: Bridges = %v, want %v", tt.name, graphType, repType, bridges, tt.bridges)
+				}
+
+				articulation := append([]string{}, g.ArticulationPoints()...)
+				slices.Sort(articulation)
+				if !slices.Equal(articulation, tt.articulation) {
+					t.Errorf("%s/%v/%v: ArticulationPoints = %v, want %v", tt.name, graphType, repType, articulation, tt.articulation)
+				}
+				if got := normalizeComponents(g.BiconnectedComponents()); !slices.Equal(got, tt.blocks) {
+					t.Errorf("%s/%v/%v: BiconnectedComponents = %v, want %v", tt.name, graphType, repType, got, tt.blocks)
+				}
+				if got := normalizeComponents(g.TwoEdgeConnectedComponents()); !slices.Equal(got, tt.twoEdged) {
+					t.Errorf("%s/%v/%v: TwoEdgeConnectedComponents = %v, want %v", tt.name, graphType, repType, got, tt.twoEdged)
+				}
+			}
+		}
+	}
+}
+
+func TestLowLinkWeighted(t *testing.T) {
+	edges := []weightedEdgeCase{{"a", "b", 1}, {"b", "c", 2}, {"c", "a", 3}, {"c", "d", 4}}
+	for _, repType := range representations {
+		g := buildWeighted(Undirected, repType, edges)
+		if got := g.Bridges(); len(got) != 1 || normalizeComponents([][]string{got[0][:]})[0] != "cd" {
+			t.Errorf("%v: Bridges = %v, want [c d]", repType, got)
+		}
+		if got := g.ArticulationPoints(); !slices.Equal(got, []string{"c"}) {
+			t.Errorf("%v: ArticulationPoints = %v, want [c]", repType, got)
+		}
+	}
+}
+
+func TestLowLinkDirected(t *testing.T) {
+	// a and b are joined both ways, which counts as two parallel edges.
+	edges := [][2]string{{"a", "b"}, {"b", "a"}, {"c", "b"}}
+	for _, repType := range representations {
+		g := buildGraph(Directed, repType, edges)
+		if got := g.Bridges(); !slices.Equal(got, [][2]string{{"c", "b"}}) {
+			t.Errorf("%v: Bridges = %v, want [[c b]]", repType, got)
+		}
+		if got := g.ArticulationPoints(); !slices.Equal(got, []string{"b"}) {
+			t.Errorf("%v: ArticulationPoints = %v, want [b]", repType, got)
+		}
+		if got := normalizeComponents(g.BiconnectedComponents()); !slices.Equal(got, []string{"ab", "bc"}) {
+			t.Errorf("%v: BiconnectedComponents = %v, want [ab bc]", repType, got)
+		}
+		if got := normalizeComponents(g.TwoEdgeConnectedComponents()); !slices.Equal(got, []string{"ab", "c"}) {
+			t.Errorf("%v: TwoEdgeConnectedComponents = %v, want [ab c]", repType, got)
+		}
+	}
+}
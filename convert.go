@@ -0,0 +1,142 @@
+package graph
+
+import "fmt"
+
+// denseThreshold is the edge density at or above which Auto picks the
+// AdjacencyMatrix representation.
+const denseThreshold = 0.25
+
+func (g *Graph[T]) Representation() RepresentationType {
+	return g.repType
+}
+
+// ConvertTo rebuilds the graph's storage in place using repType, keeping all
+// nodes, edges and attributes. Auto picks AdjacencyMatrix for dense graphs and
+// AdjacencyList otherwise. A graph converted back to AdjacencyMatrix keeps the
+// index order it had the last time it was a matrix; nodes added since are
+// appended. It returns an error wrapping ErrRepresentation for CSR, which only
+// Freeze builds, and for values that are not a RepresentationType.
+func (g *Graph[T]) ConvertTo(repType RepresentationType) error {
+	if err := checkConversion(repType); err != nil {
+		return err
+	}
+	if repType == Auto {
+		repType = chooseRepresentation(len(g.nodes), len(g.Edges()), g.graphType)
+	}
+	if repType == g.repType {
+		return nil
+	}
+	nodes := conversionOrder(g.Nodes(), g.indexToNodes, g.nodes)
+	edges := [][2]T{}
+	for _, from := range nodes {
+		for _, to := range g.Neighbours(from) {
+			edges = append(edges, [2]T{from, to})
+		}
+	}
+
+	order := g.indexToNodes
+	attrs := g.attrs
+	log := g.log
+	if g.shared {
+		attrs = g.attrs.clone()
+	}
+	*g = *NewGraph[T](g.graphType, repType)
+	g.attrs = attrs
+	if repType == AdjacencyList {
+		g.indexToNodes = order
+	}
+	for _, node := range nodes {
+		g.AddNode(node)
+	}
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	// the rebuild is not a change of its own, so it is not recorded
+	g.log = log
+	return nil
+}
+
+func (g *WeightedGraph[T, W]) Representation() RepresentationType {
+	return g.repType
+}
+
+func (g *WeightedGraph[T, W]) ConvertTo(repType RepresentationType) error {
+	if err := checkConversion(repType); err != nil {
+		return err
+	}
+	if repType == Auto {
+		repType = chooseRepresentation(len(g.nodes), len(g.Edges()), g.graphType)
+	}
+	if repType == g.repType {
+		return nil
+	}
+	nodes := conversionOrder(g.Nodes(), g.indexToNodes, g.nodes)
+	edges := arcs[T](g)
+
+	order := g.indexToNodes
+	attrs := g.attrs
+	log := g.log
+	if g.shared {
+		attrs = g.attrs.clone()
+	}
+	*g = *NewWeightedGraph[T, W](g.graphType, repType)
+	g.attrs = attrs
+	if repType == AdjacencyList {
+		g.indexToNodes = order
+	}
+	for _, node := range nodes {
+		g.AddNode(node)
+	}
+	for _, e := range edges {
+		g.AddEdge(e.Edge[0], e.Edge[1], e.Weight)
+	}
+	g.log = log
+	return nil
+}
+
+// checkConversion returns an error unless ConvertTo and the checked
+// constructors accept repType.
+func checkConversion(repType RepresentationType) error {
+	switch repType {
+	case AdjacencyList, AdjacencyMatrix, Auto:
+		return nil
+	case CSR:
+		return fmt.Errorf("%w: CSR graphs are built with Freeze", ErrRepresentation)
+	}
+	return fmt.Errorf("%w: %d", ErrRepresentation, repType)
+}
+
+// chooseRepresentation returns AdjacencyMatrix when edges fill at least
+// denseThreshold of the possible node pairs.
+func chooseRepresentation(nodes int, edges int, graphType GraphType) RepresentationType {
+	pairs := nodes * (nodes - 1)
+	if graphType == Undirected {
+		pairs /= 2
+	}
+	if pairs > 0 && float64(edges) >= denseThreshold*float64(pairs) {
+		return AdjacencyMatrix
+	}
+	return AdjacencyList
+}
+
+// conversionOrder lists nodes with those still present from the remembered
+// matrix order first, followed by the rest in their current order.
+func conversionOrder[T comparable](nodes []T, order []T, present map[T]struct{}) []T {
+	result := make([]T, 0, len(nodes))
+	seen := make(map[T]struct{}, len(nodes))
+	for _, node := range order {
+		if _, ok := present[node]; !ok {
+			continue
+		}
+		if _, ok := seen[node]; !ok {
+			seen[node] = struct{}{}
+			result = append(result, node)
+		}
+	}
+	for _, node := range nodes {
+		if _, ok := seen[node]; !ok {
+			result = append(result, node)
+		}
+	}
+	return result
+}
@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestConvertToRoundTrip(t *testing.T) {
+	for _, graphType := range []GraphType{Directed, Undirected} {
+		g := buildWeighted(graphType, AdjacencyList, []weightedEdgeCase{{"a", "b", 2}, {"b", "c", 5}, {"c", "c", 1}, {"c", "a", 4}})
+		g.AddNode("z")
+		SetNodeAttr(g, "a", "colour", "red")
+		SetEdgeAttr(g, "b", "c", "road", "A1")
+		want := normalizeWeightedEdges(g.Edges(), graphType)
+
+		for _, repType := range []RepresentationType{AdjacencyMatrix, AdjacencyList, AdjacencyMatrix} {
+			if err := g.ConvertTo(repType); err != nil {
+				t.Fatalf("%v: ConvertTo(%v): %v", graphType, repType, err)
+			}
+			if g.Representation() != repType {
+				t.Errorf("%v: Representation() = %v, want %v", graphType, g.Representation(), repType)
+			}
+			if got := normalizeWeightedEdges(g.Edges(), graphType); !slices.Equal(got, want) {
+				t.Errorf("%v/%v: edges %v, want %v", graphType, repType, got, want)
+			}
+			if !g.HasNode("z") || len(g.Nodes()) != 4 {
+				t.Errorf("%v/%v: nodes %v", graphType, repType, g.Nodes())
+			}
+			if v, _ := NodeAttr[string](g, "a", "colour"); v != "red" {
+				t.Errorf("%v/%v: node attribute lost", graphType, repType)
+			}
+			if v, _ := EdgeAttr[string](g, "b", "c", "road"); v != "A1" {
+				t.Errorf("%v/%v: edge attribute lost", graphType, repType)
+			}
+		}
+	}
+}
+
+// normalizeWeightedEdges sorts edges, orienting undirected ones from the
+// smaller node.
+func normalizeWeightedEdges(edges []WeightedEdge[string, int], graphType GraphType) []WeightedEdge[string, int] {
+	out := slices.Clone(edges)
+	for i, e := range out {
+		if graphType == Undirected && e.Edge[0] > e.Edge[1] {
+			out[i].Edge = [2]string{e.Edge[1], e.Edge[0]}
+		}
+	}
+	slices.SortFunc(out, func(p, q WeightedEdge[string, int]) int {
+		if p.Edge[0] != q.Edge[0] {
+			return strings.Compare(p.Edge[0], q.Edge[0])
+		}
+		return strings.Compare(p.Edge[1], q.Edge[1])
+	})
+	return out
+}
+
+func TestConvertToKeepsMatrixOrder(t *testing.T) {
+	g := NewGraph[string](Directed, AdjacencyMatrix)
+	for _, node := range []string{"c", "a", "d", "b"} {
+		g.AddNode(node)
+	}
+	g.AddEdge("c", "b")
+	g.ConvertTo(AdjacencyList)
+	g.RemoveNode("a")
+	g.AddNode("e")
+	g.ConvertTo(AdjacencyMatrix)
+	if want := []string{"c", "d", "b", "e"}; !slices.Equal(g.Nodes(), want) {
+		t.Errorf("matrix order %v, want %v", g.Nodes(), want)
+	}
+	if !g.HasEdge("c", "b") || g.HasEdge("b", "c") {
+		t.Errorf("edges after round trip: %v", g.Edges())
+	}
+}
+
+func TestConvertToAuto(t *testing.T) {
+	tests := []struct {
+		name      string
+		graphType GraphType
+		nodes     int
+		edges     [][2]int
+		want      RepresentationType
+	}{
+		{"sparse path", Undirected, 10, [][2]int{{0, 1}, {1, 2}, {2, 3}}, AdjacencyList},
+		{"dense triangle", Undirected, 3, [][2]int{{0, 1}, {1, 2}}, AdjacencyMatrix},
+		{"directed quarter", Directed, 4, [][2]int{{0, 1}, {1, 2}, {2, 3}}, AdjacencyMatrix},
+		{"directed below a quarter", Directed, 5, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}}, AdjacencyList},
+		{"no edges", Directed, 1, nil, AdjacencyList},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := NewGraph[int](tt.graphType, repType)
+			for i := 0; i < tt.nodes; i++ {
+				g.AddNode(i)
+			}
+			for _, e := range tt.edges {
+				g.AddEdge(e[0], e[1])
+			}
+			if err := g.ConvertTo(Auto); err != nil || g.Representation() != tt.want {
+				t.Errorf("%s/%v: got %v, %v, want %v", tt.name, repType, g.Representation(), err, tt.want)
+			}
+			if len(g.Edges()) != len(tt.edges) {
+				t.Errorf("%s/%v: edges %v after conversion", tt.name, repType, g.Edges())
+			}
+		}
+	}
+	if g := NewGraph[int](Directed, Auto); g.Representation() != AdjacencyList {
+		t.Errorf("NewGraph(Auto) = %v, want AdjacencyList", g.Representation())
+	}
+}
+
+func TestConvertToKeepsHistory(t *testing.T) {
+	g := NewGraph[string](Undirected, AdjacencyList)
+	g.EnableUndo(0)
+	g.AddEdge("a", "b")
+	g.ConvertTo(AdjacencyMatrix)
+	if !g.Undo() || g.HasEdge("a", "b") {
+		t.Errorf("undo after conversion: edges %v", g.Edges())
+	}
+	if !g.Redo() || !g.HasEdge("a", "b") {
+		t.Errorf("redo after conversion: edges %v", g.Edges())
+	}
+}
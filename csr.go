@@ -0,0 +1,474 @@
+package graph
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// CSRGraph is a read-only graph in compressed sparse row layout. Nodes are
+// numbered 0..n-1 and the out-neighbours of node i are
+// Targets()[Offsets()[i]:Offsets()[i+1]], sorted by id, with the matching
+// edge weights at the same positions of Weights(). Undirected edges are
+// stored in both directions. A CSRGraph is built with Freeze and can be
+// passed to every package-level algorithm.
+type CSRGraph[T comparable, W Number] struct {
+	graphType GraphType
+
+	nodes   []T
+	ids     map[T]int
+	offsets []int
+	targets []int
+	weights []W // nil when frozen from an unweighted graph
+
+	// targetNodes holds the node at each position of targets, so that
+	// Neighbours can return a row without copying
+	targetNodes []T
+
+	attrs attributes[T]
+}
+
+var _ WeightedTraversable[int, float64] = (*CSRGraph[int, float64])(nil)
+
+func (g *Graph[T]) Freeze() *CSRGraph[T, int] {
+	return Freeze[T, int](g)
+}
+
+func (g *WeightedGraph[T, W]) Freeze() *CSRGraph[T, W] {
+	return Freeze[T, W](g)
+}
+
+// Freeze copies g into a CSRGraph. Node ids follow the order of g.Nodes(), so
+// a graph using AdjacencyMatrix keeps its matrix indices. Edge weights are
+// kept when g is a WeightedTraversable[T, W]; otherwise every edge weighs 1.
+// Node and edge attributes are copied when g is Attributed.
+func Freeze[T comparable, W Number](g Traversable[T]) *CSRGraph[T, W] {
+	nodes := g.Nodes()
+	csr := &CSRGraph[T, W]{
+		nodes:       nodes,
+		ids:         make(map[T]int, len(nodes)),
+		offsets:     make([]int, 1, len(nodes)+1),
+		targets:     []int{},
+		targetNodes: []T{},
+	}
+	if g.IsDirected() {
+		csr.graphType = Directed
+	} else {
+		csr.graphType = Undirected
+	}
+	for i, node := range nodes {
+		csr.ids[node] = i
+	}
+	if attributed, ok := g.(Attributed[T]); ok {
+		csr.attrs = attributed.attributeStore().clone()
+	}
+	weighted, isWeighted := g.(WeightedTraversable[T, W])
+	if isWeighted {
+		csr.weights = []W{}
+	}
+	for _, node := range nodes {
+		row := []int{}
+		for _, nbr := range g.Neighbours(node) {
+			row = append(row, csr.ids[nbr])
+		}
+		sort.Ints(row)
+		for _, id := range row {
+			csr.targets = append(csr.targets, id)
+			csr.targetNodes = append(csr.targetNodes, nodes[id])
+			if isWeighted {
+				weight, _ := weighted.Weight(node, nodes[id])
+				csr.weights = append(csr.weights, weight)
+			}
+		}
+		csr.offsets = append(csr.offsets, len(csr.targets))
+	}
+	return csr
+}
+
+func (g *CSRGraph[T, W]) Representation() RepresentationType {
+	return CSR
+}
+
+func (g *CSRGraph[T, W]) IsDirected() bool {
+	return g.graphType == Directed
+}
+
+// ID returns the integer id of node.
+func (g *CSRGraph[T, W]) ID(node T) (int, bool) {
+	id, ok := g.ids[node]
+	return id, ok
+}
+
+// Node returns the node with the given id.
+func (g *CSRGraph[T, W]) Node(id int) T {
+	return g.nodes[id]
+}
+
+func (g *CSRGraph[T, W]) NodeCount() int {
+	return len(g.nodes)
+}
+
+// Offsets, Targets and Weights expose the underlying arrays, which must not
+// be modified. Weights is nil for graphs frozen from an unweighted graph.
+func (g *CSRGraph[T, W]) Offsets() []int {
+	return g.offsets
+}
+
+func (g *CSRGraph[T, W]) Targets() []int {
+	return g.targets
+}
+
+func (g *CSRGraph[T, W]) Weights() []W {
+	return g.weights
+}
+
+// NeighbourIDs returns the ids of the out-neighbours of id without copying.
+func (g *CSRGraph[T, W]) NeighbourIDs(id int) []int {
+	return g.targets[g.offsets[id]:g.offsets[id+1]]
+}
+
+// arc returns the position of the edge from from to to in targets, or -1.
+func (g *CSRGraph[T, W]) arc(from int, to int) int {
+	row := g.NeighbourIDs(from)
+	i := sort.SearchInts(row, to)
+	if i < len(row) && row[i] == to {
+		return g.offsets[from] + i
+	}
+	return -1
+}
+
+// weightAt returns the weight stored at position arc; unweighted edges
+// weigh 1.
+func (g *CSRGraph[T, W]) weightAt(arc int) W {
+	if g.weights == nil {
+		return 1
+	}
+	return g.weights[arc]
+}
+
+func (g *CSRGraph[T, W]) HasNode(node T) bool {
+	_, ok := g.ids[node]
+	return ok
+}
+
+func (g *CSRGraph[T, W]) HasEdge(from T, to T) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+// Weight returns the weight of the edge from from to to. Edges of a graph
+// frozen from an unweighted Graph weigh 1.
+func (g *CSRGraph[T, W]) Weight(from T, to T) (W, bool) {
+	f, fOk := g.ids[from]
+	t, tOk := g.ids[to]
+	if !fOk || !tOk {
+		return Infinity[W](), false
+	}
+	arc := g.arc(f, t)
+	if arc < 0 {
+		return Infinity[W](), false
+	}
+	return g.weightAt(arc), true
+}
+
+func (g *CSRGraph[T, W]) Nodes() []T {
+	return append([]T{}, g.nodes...)
+}
+
+// Neighbours returns the out-neighbours of node, sorted by id. Like
+// NeighbourIDs it does not copy: the result is part of the graph's storage
+// and must not be modified.
+func (g *CSRGraph[T, W]) Neighbours(node T) []T {
+	id, ok := g.ids[node]
+	if !ok {
+		return make([]T, 0)
+	}
+	start, end := g.offsets[id], g.offsets[id+1]
+	return g.targetNodes[start:end:end]
+}
+
+func (g *CSRGraph[T, W]) Edges() []WeightedEdge[T, W] {
+	edges := make([]WeightedEdge[T, W], 0)
+	for from := range g.nodes {
+		for arc := g.offsets[from]; arc < g.offsets[from+1]; arc++ {
+			to := g.targets[arc]
+			if g.graphType == Undirected && to < from {
+				continue
+			}
+			edges = append(edges, WeightedEdge[T, W]{Edge: [2]T{g.nodes[from], g.nodes[to]}, Weight: g.weightAt(arc)})
+		}
+	}
+	return edges
+}
+
+func (g *CSRGraph[T, W]) OutDegree(node T) int {
+	id, ok := g.ids[node]
+	if !ok {
+		return 0
+	}
+	return g.offsets[id+1] - g.offsets[id]
+}
+
+func (g *CSRGraph[T, W]) InDegree(node T) int {
+	id, ok := g.ids[node]
+	if !ok {
+		return 0
+	}
+	if g.graphType == Undirected {
+		return g.OutDegree(node)
+	}
+	count := 0
+	for _, to := range g.targets {
+		if to == id {
+			count++
+		}
+	}
+	return count
+}
+
+func (g *CSRGraph[T, W]) Degree(node T) int {
+	return g.OutDegree(node)
+}
+
+func (g *CSRGraph[T, W]) BFS(start T) []T {
+	s, ok := g.ids[start]
+	if !ok {
+		return []T{start}
+	}
+	order := []T{}
+	visited := make([]bool, len(g.nodes))
+	visited[s] = true
+	queue := []int{s}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		order = append(order, g.nodes[curr])
+		for _, nbr := range g.NeighbourIDs(curr) {
+			if !visited[nbr] {
+				visited[nbr] = true
+				queue = append(queue, nbr)
+			}
+		}
+	}
+	return order
+}
+
+func (g *CSRGraph[T, W]) DFSRecursive(start T) []T {
+	s, ok := g.ids[start]
+	if !ok {
+		return []T{start}
+	}
+	order := []T{}
+	visited := make([]bool, len(g.nodes))
+	var dfs func(curr int)
+	dfs = func(curr int) {
+		visited[curr] = true
+		order = append(order, g.nodes[curr])
+		for _, nbr := range g.NeighbourIDs(curr) {
+			if !visited[nbr] {
+				dfs(nbr)
+			}
+		}
+	}
+	dfs(s)
+	return order
+}
+
+// DFSIterative visits nodes in the same order as DFSRecursive.
+func (g *CSRGraph[T, W]) DFSIterative(start T) []T {
+	s, ok := g.ids[start]
+	if !ok {
+		return []T{start}
+	}
+	order := []T{}
+	visited := make([]bool, len(g.nodes))
+	stack := []int{s}
+	for len(stack) > 0 {
+		curr := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[curr] {
+			continue
+		}
+		visited[curr] = true
+		order = append(order, g.nodes[curr])
+		row := g.NeighbourIDs(curr)
+		for i := len(row) - 1; i >= 0; i-- {
+			if !visited[row[i]] {
+				stack = append(stack, row[i])
+			}
+		}
+	}
+	return order
+}
+
+func (g *CSRGraph[T, W]) BFSShortestPath(source T, target T) []T {
+	s, sOk := g.ids[source]
+	t, tOk := g.ids[target]
+	if !sOk || !tOk {
+		return []T{}
+	}
+	parent := make([]int, len(g.nodes))
+	for i := range parent {
+		parent[i] = -1
+	}
+	parent[s] = s
+	queue := []int{s}
+	for len(queue) > 0 && parent[t] == -1 {
+		curr := queue[0]
+		queue = queue[1:]
+		for _, nbr := range g.NeighbourIDs(curr) {
+			if parent[nbr] == -1 {
+				parent[nbr] = curr
+				queue = append(queue, nbr)
+			}
+		}
+	}
+	if parent[t] == -1 {
+		return []T{}
+	}
+	return g.pathTo(parent, s, t)
+}
+
+// Dijkstra is the package-level Dijkstra run directly on the id arrays.
+func (g *CSRGraph[T, W]) Dijkstra(source T) (map[T]W, map[T]T) {
+	dist := make(map[T]W, len(g.nodes))
+	prev := map[T]T{}
+	for _, node := range g.nodes {
+		dist[node] = Infinity[W]()
+	}
+	s, ok := g.ids[source]
+	if !ok {
+		return dist, prev
+	}
+	d, parent := g.dijkstra(s, -1)
+	for id, node := range g.nodes {
+		if parent[id] == -1 {
+			continue
+		}
+		dist[node] = d[id]
+		if id != s {
+			prev[node] = g.nodes[parent[id]]
+		}
+	}
+	return dist, prev
+}
+
+func (g *CSRGraph[T, W]) DijkstraShortestPath(source T, target T) ([]T, W) {
+	s, sOk := g.ids[source]
+	t, tOk := g.ids[target]
+	if !sOk || !tOk {
+		return []T{}, Infinity[W]()
+	}
+	dist, parent := g.dijkstra(s, t)
+	if parent[t] == -1 {
+		return []T{}, Infinity[W]()
+	}
+	return g.pathTo(parent, s, t), dist[t]
+}
+
+// dijkstra returns distances and parents by id; unreached nodes have a
+// parent of -1 and the source is its own parent. It stops once target is
+// settled, unless target is -1.
+func (g *CSRGraph[T, W]) dijkstra(s int, target int) ([]W, []int) {
+	dist := make([]W, len(g.nodes))
+	parent := make([]int, len(g.nodes))
+	settled := make([]bool, len(g.nodes))
+	for i := range parent {
+		parent[i] = -1
+	}
+	parent[s] = s
+	pq := &priorityQueue[int, W]{{node: s, priority: 0}}
+	for pq.Len() > 0 {
+		curr := heap.Pop(pq).(pqItem[int, W])
+		if settled[curr.node] {
+			continue
+		}
+		settled[curr.node] = true
+		if curr.node == target {
+			break
+		}
+		for arc := g.offsets[curr.node]; arc < g.offsets[curr.node+1]; arc++ {
+			nbr := g.targets[arc]
+			alt := curr.priority + g.weightAt(arc)
+			if parent[nbr] == -1 || alt < dist[nbr] {
+				dist[nbr] = alt
+				parent[nbr] = curr.node
+				heap.Push(pq, pqItem[int, W]{node: nbr, priority: alt})
+			}
+		}
+	}
+	return dist, parent
+}
+
+func (g *CSRGraph[T, W]) pathTo(parent []int, s int, t int) []T {
+	path := []T{}
+	for v := t; ; v = parent[v] {
+		path = append(path, g.nodes[v])
+		if v == s {
+			break
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+func (g *CSRGraph[T, W]) HasCycleDirected() bool {
+	// 0 = unvisited, 1 = on the stack, 2 = finished
+	state := make([]int, len(g.nodes))
+	next := make([]int, len(g.nodes))
+	for root := range g.nodes {
+		if state[root] != 0 {
+			continue
+		}
+		state[root] = 1
+		next[root] = g.offsets[root]
+		stack := []int{root}
+		for len(stack) > 0 {
+			curr := stack[len(stack)-1]
+			if next[curr] == g.offsets[curr+1] {
+				state[curr] = 2
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			nbr := g.targets[next[curr]]
+			next[curr]++
+			switch state[nbr] {
+			case 0:
+				state[nbr] = 1
+				next[nbr] = g.offsets[nbr]
+				stack = append(stack, nbr)
+			case 1:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *CSRGraph[T, W]) HasCycleUndirected() bool {
+	parent := make([]int, len(g.nodes))
+	for i := range parent {
+		parent[i] = -1
+	}
+	for root := range g.nodes {
+		if parent[root] != -1 {
+			continue
+		}
+		parent[root] = root
+		stack := []int{root}
+		for len(stack) > 0 {
+			curr := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, nbr := range g.NeighbourIDs(curr) {
+				if parent[nbr] == -1 {
+					parent[nbr] = curr
+					stack = append(stack, nbr)
+				} else if nbr != parent[curr] || nbr == curr {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
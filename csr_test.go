@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestFreeze(t *testing.T) {
+	for _, graphType := range []GraphType{Directed, Undirected} {
+		for _, repType := range representations {
+			g := buildWeighted(graphType, repType, []weightedEdgeCase{
+				{"a", "b", 4}, {"a", "c", 1}, {"c", "b", 2}, {"b", "d", 5},
+			})
+			g.AddNode("e")
+			csr := g.Freeze()
+
+			if got := csr.Representation(); got != CSR {
+				t.Fatalf("Representation() = %v, want CSR", got)
+			}
+			if csr.NodeCount() != 5 || len(csr.Edges()) != len(g.Edges()) {
+				t.Errorf("%v/%v: %d nodes, %d edges", graphType, repType, csr.NodeCount(), len(csr.Edges()))
+			}
+			for _, from := range g.Nodes() {
+				for _, to := range g.Nodes() {
+					want, wantOK := g.Weight(from, to)
+					got, ok := csr.Weight(from, to)
+					if ok != wantOK || (ok && got != want) {
+						t.Errorf("%v/%v: Weight(%s, %s) = %d, %v, want %d, %v", graphType, repType, from, to, got, ok, want, wantOK)
+					}
+				}
+				if csr.OutDegree(from) != g.OutDegree(from) || csr.InDegree(from) != g.InDegree(from) {
+					t.Errorf("%v/%v: degrees of %s differ", graphType, repType, from)
+				}
+			}
+			path, cost := csr.DijkstraShortestPath("a", "d")
+			if !slices.Equal(path, []string{"a", "c", "b", "d"}) || cost != 8 {
+				t.Errorf("%v/%v: DijkstraShortestPath = %v, %d", graphType, repType, path, cost)
+			}
+			if got := csr.BFSShortestPath("a", "d"); !slices.Equal(got, []string{"a", "b", "d"}) {
+				t.Errorf("%v/%v: BFSShortestPath = %v", graphType, repType, got)
+			}
+			if got := len(csr.BFS("a")); got != 4 {
+				t.Errorf("%v/%v: BFS reached %d nodes, want 4", graphType, repType, got)
+			}
+			if got, want := csr.HasCycleDirected(), g.HasCycleDirected(); graphType == Directed && got != want {
+				t.Errorf("%v/%v: HasCycleDirected = %v, want %v", graphType, repType, got, want)
+			}
+		}
+	}
+}
+
+func TestFreezeIsIndependent(t *testing.T) {
+	g := NewGraph[int](Directed, AdjacencyMatrix)
+	g.AddEdge(1, 2)
+	csr := g.Freeze()
+	g.AddEdge(2, 3)
+	if csr.HasNode(3) || csr.HasEdge(2, 3) {
+		t.Errorf("frozen graph sees later changes")
+	}
+	if w, ok := csr.Weight(1, 2); !ok || w != 1 {
+		t.Errorf("Weight(1, 2) = %d, %v, want 1, true", w, ok)
+	}
+	if id, ok := csr.ID(2); !ok || csr.Node(id) != 2 {
+		t.Errorf("ID(2) does not round-trip")
+	}
+}
+
+func TestCSRRepresentationArgument(t *testing.T) {
+	g := NewGraph[int](Directed, CSR)
+	if g.Representation() != AdjacencyList {
+		t.Errorf("NewGraph(CSR) representation = %v, want AdjacencyList", g.Representation())
+	}
+	w := NewWeightedGraph[int, int](Directed, CSR)
+	if w.Representation() != AdjacencyList {
+		t.Errorf("NewWeightedGraph(CSR) representation = %v, want AdjacencyList", w.Representation())
+	}
+	tests := []struct {
+		repType RepresentationType
+		err     error
+	}{
+		{CSR, ErrRepresentation},
+		{RepresentationType(42), ErrRepresentation},
+		{AdjacencyMatrix, nil},
+		{Auto, nil},
+	}
+	for _, tt := range tests {
+		if _, err := NewGraphChecked[int](Directed, tt.repType); !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("NewGraphChecked(%v) = %v, want %v", tt.repType, err, tt.err)
+		}
+		if _, err := NewWeightedGraphChecked[int, int](Directed, tt.repType); !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("NewWeightedGraphChecked(%v) = %v, want %v", tt.repType, err, tt.err)
+		}
+		if err := g.ConvertTo(tt.repType); !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("Graph.ConvertTo(%v) = %v, want %v", tt.repType, err, tt.err)
+		}
+		if err := w.ConvertTo(tt.repType); !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("WeightedGraph.ConvertTo(%v) = %v, want %v", tt.repType, err, tt.err)
+		}
+	}
+}
+
+func TestCSRNeighboursShareStorage(t *testing.T) {
+	g := NewGraph[int](Directed, AdjacencyList)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+	csr := g.Freeze()
+	first, second := csr.Neighbours(1), csr.Neighbours(1)
+	if len(first) != 2 || &first[0] != &second[0] {
+		t.Errorf("Neighbours copied its row: %v", first)
+	}
+	if grown := append(first, 9); !slices.Equal(csr.Neighbours(2), []int{3}) || len(grown) != 3 {
+		t.Errorf("appending to a row changed the next one: %v", csr.Neighbours(2))
+	}
+	if allocs := testing.AllocsPerRun(10, func() { csr.Neighbours(1) }); allocs != 0 {
+		t.Errorf("Neighbours allocates %v times per call", allocs)
+	}
+}
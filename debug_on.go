@@ -0,0 +1,5 @@
+//go:build graphdebug
+
+package graph
+
+const debug = true
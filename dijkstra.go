@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+func (g *WeightedGraph[T, W]) Dijkstra(source T) (map[T]W, map[T]T) {
+	return Dijkstra[T, W](g, source)
+}
+
+func (g *WeightedGraph[T, W]) DijkstraShortestPath(source T, target T) ([]T, W) {
+	return DijkstraShortestPath[T, W](g, source, target)
+}
+
+func (g *WeightedGraph[T, W]) DijkstraChecked(source T) (map[T]W, map[T]T, error) {
+	return DijkstraChecked[T, W](g, source)
+}
+
+func (g *WeightedGraph[T, W]) DijkstraShortestPathChecked(source T, target T) ([]T, W, error) {
+	return DijkstraShortestPathChecked[T, W](g, source, target)
+}
+
+// Dijkstra computes the cheapest distance from source to every node in the
+// graph. Unreachable nodes are reported with a distance of Infinity[W](). The returned
+// predecessor map links every reached node (except source) to the node it was
+// reached from. Edge weights are assumed to be non-negative.
+func Dijkstra[T comparable, W Number](g WeightedTraversable[T, W], source T) (map[T]W, map[T]T) {
+	dist, prev := dijkstra(g, source, nil, weightOf(g))
+	for _, node := range g.Nodes() {
+		if _, ok := dist[node]; !ok {
+			dist[node] = Infinity[W]()
+		}
+	}
+	return dist, prev
+}
+
+// DijkstraShortestPath returns the cheapest path from source to target and
+// its total cost. If target is unreachable it returns an empty path and
+// Infinity[W]().
+func DijkstraShortestPath[T comparable, W Number](g WeightedTraversable[T, W], source T, target T) ([]T, W) {
+	dist, prev := dijkstra(g, source, &target, weightOf(g))
+	cost, ok := dist[target]
+	if !ok {
+		return []T{}, Infinity[W]()
+	}
+	return buildPath(prev, source, target), cost
+}
+
+// DijkstraChecked is Dijkstra, but fails with ErrNodeNotFound if source is
+// missing and with ErrNegativeWeight if any edge weight is negative.
+func DijkstraChecked[T comparable, W Number](g WeightedTraversable[T, W], source T) (map[T]W, map[T]T, error) {
+	if err := checkDijkstra(g, source); err != nil {
+		return nil, nil, err
+	}
+	dist, prev := Dijkstra(g, source)
+	return dist, prev, nil
+}
+
+// DijkstraShortestPathChecked is DijkstraShortestPath, but fails with
+// ErrNodeNotFound, ErrNegativeWeight, or ErrNoPath if target is unreachable.
+func DijkstraShortestPathChecked[T comparable, W Number](g WeightedTraversable[T, W], source T, target T) ([]T, W, error) {
+	if err := checkDijkstra(g, source, target); err != nil {
+		return nil, 0, err
+	}
+	path, cost := DijkstraShortestPath(g, source, target)
+	if len(path) == 0 {
+		return nil, 0, noPath(source, target)
+	}
+	return path, cost, nil
+}
+
+func checkDijkstra[T comparable, W Number](g WeightedTraversable[T, W], nodes ...T) error {
+	if err := requireNodes[T](g, nodes...); err != nil {
+		return err
+	}
+	for _, e := range arcs(g) {
+		if e.Weight < 0 {
+			return fmt.Errorf("%w: %v -> %v is %v", ErrNegativeWeight, e.Edge[0], e.Edge[1], e.Weight)
+		}
+	}
+	return nil
+}
+
+// dijkstra runs a lazy-deletion Dijkstra from source using weight to price
+// each edge. When target is not nil the search stops as soon as target is
+// settled.
+func dijkstra[T comparable, W Number](g Traversable[T], source T, target *T, weight func(from T, to T) W) (map[T]W, map[T]T) {
+	dist := map[T]W{}
+	prev := map[T]T{}
+	if !g.HasNode(source) {
+		return dist, prev
+	}
+	dist[source] = 0
+	settled := map[T]struct{}{}
+	pq := &priorityQueue[T, W]{{node: source, priority: 0}}
+	for pq.Len() > 0 {
+		curr := heap.Pop(pq).(pqItem[T, W])
+		if _, done := settled[curr.node]; done {
+			continue
+		}
+		settled[curr.node] = struct{}{}
+		if target != nil && curr.node == *target {
+			break
+		}
+		for _, nbr := range g.Neighbours(curr.node) {
+			alt := curr.priority + weight(curr.node, nbr)
+			if d, seen := dist[nbr]; !seen || alt < d {
+				dist[nbr] = alt
+				prev[nbr] = curr.node
+				heap.Push(pq, pqItem[T, W]{node: nbr, priority: alt})
+			}
+		}
+	}
+	return dist, prev
+}
+
+// buildPath walks a predecessor map back from target to source and returns
+// the path in source to target order.
+func buildPath[T comparable](prev map[T]T, source T, target T) []T {
+	path := []T{target}
+	for node := target; node != source; {
+		node = prev[node]
+		path = append(path, node)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
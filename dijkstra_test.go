@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+var representations = []RepresentationType{AdjacencyList, AdjacencyMatrix}
+
+type weightedEdgeCase struct {
+	from, to string
+	weight   int
+}
+
+func buildWeighted(graphType GraphType, repType RepresentationType, edges []weightedEdgeCase) *WeightedGraph[string, int] {
+	g := NewWeightedGraph[string, int](graphType, repType)
+	for _, e := range edges {
+		g.AddEdge(e.from, e.to, e.weight)
+	}
+	return g
+}
+
+// roadGraph has a cheap long route a-c-d-b-e and an expensive direct a-e,
+// plus the unreachable node z.
+var roadGraph = []weightedEdgeCase{
+	{"a", "c", 1}, {"c", "d", 2}, {"d", "b", 1}, {"b", "e", 1},
+	{"a", "b", 7}, {"a", "e", 20}, {"c", "e", 9},
+}
+
+func TestDijkstraShortestPath(t *testing.T) {
+	tests := []struct {
+		name           string
+		source, target string
+		path           []string
+		cost           int
+	}{
+		{"long cheap route", "a", "e", []string{"a", "c", "d", "b", "e"}, 5},
+		{"source is target", "c", "c", []string{"c"}, 0},
+		{"intermediate", "a", "b", []string{"a", "c", "d", "b"}, 4},
+		{"unreachable", "a", "z", []string{}, Infinity[int]()},
+	}
+	for _, repType := range representations {
+		g := buildWeighted(Directed, repType, roadGraph)
+		g.AddNode("z")
+		for _, tt := range tests {
+			path, cost := g.DijkstraShortestPath(tt.source, tt.target)
+			if !slices.Equal(path, tt.path) || cost != tt.cost {
+				t.Errorf("%v/%s: got %v, %d, want %v, %d", repType, tt.name, path, cost, tt.path, tt.cost)
+			}
+		}
+	}
+}
+
+func TestDijkstraDistances(t *testing.T) {
+	want := map[string]int{"a": 0, "b": 4, "c": 1, "d": 3, "e": 5, "z": Infinity[int]()}
+	for _, repType := range representations {
+		g := buildWeighted(Directed, repType, roadGraph)
+		g.AddNode("z")
+		dist, prev := g.Dijkstra("a")
+		for node, d := range want {
+			if dist[node] != d {
+				t.Errorf("%v: dist[%s] = %d, want %d", repType, node, dist[node], d)
+			}
+		}
+		if _, ok := prev["a"]; ok {
+			t.Errorf("%v: source has a predecessor", repType)
+		}
+		if prev["e"] != "b" || prev["b"] != "d" {
+			t.Errorf("%v: wrong predecessors %v", repType, prev)
+		}
+	}
+}
+
+func TestDijkstraFloatWeights(t *testing.T) {
+	g := NewWeightedGraph[int, float64](Undirected, AdjacencyMatrix)
+	g.AddEdge(1, 2, 0.25)
+	g.AddEdge(2, 3, 0.5)
+	g.AddEdge(1, 3, 0.8)
+	g.AddNode(4)
+	path, cost := g.DijkstraShortestPath(3, 1)
+	if !slices.Equal(path, []int{3, 2, 1}) || cost != 0.75 {
+		t.Errorf("got %v, %v", path, cost)
+	}
+	if _, cost := g.DijkstraShortestPath(1, 4); cost != Infinity[float64]() {
+		t.Errorf("unreachable cost = %v, want +Inf", cost)
+	}
+}
+
+func TestDijkstraChecked(t *testing.T) {
+	g := buildWeighted(Directed, AdjacencyList, roadGraph)
+	g.AddNode("z")
+	tests := []struct {
+		name           string
+		source, target string
+		err            error
+	}{
+		{"reachable", "a", "e", nil},
+		{"unreachable", "a", "z", ErrNoPath},
+		{"missing source", "q", "e", ErrNodeNotFound},
+		{"missing target", "a", "q", ErrNodeNotFound},
+	}
+	for _, tt := range tests {
+		_, _, err := g.DijkstraShortestPathChecked(tt.source, tt.target)
+		if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+	g.AddEdge("e", "a", -1)
+	if _, _, err := g.DijkstraChecked("a"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("negative edge: err = %v, want ErrNegativeWeight", err)
+	}
+}
+
+func TestInfinity(t *testing.T) {
+	if got := Infinity[int](); got != math.MaxInt {
+		t.Errorf("Infinity[int]() = %d", got)
+	}
+	if got := Infinity[int32](); got != 1<<31-1 {
+		t.Errorf("Infinity[int32]() = %d", got)
+	}
+	if got := Infinity[float32](); got <= 3.4e38 {
+		t.Errorf("Infinity[float32]() = %v", got)
+	}
+}
@@ -0,0 +1,277 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned by the error-returning API. Errors carrying more
+// detail wrap one of these, so callers should compare with errors.Is.
+var (
+	ErrNodeNotFound    = errors.New("graph: node not found")
+	ErrEdgeNotFound    = errors.New("graph: edge not found")
+	ErrNoPath          = errors.New("graph: no path")
+	ErrCycle           = errors.New("graph: cycle")
+	ErrNegativeWeight  = errors.New("graph: negative weight")
+	ErrNoTransaction   = errors.New("graph: no transaction")
+	ErrRepresentation  = errors.New("graph: unsupported representation")
+	ErrInvalidArgument = errors.New("graph: invalid argument")
+)
+
+// requireNodes returns an error wrapping ErrNodeNotFound for the first of
+// nodes that is not in g.
+func requireNodes[T comparable](g Traversable[T], nodes ...T) error {
+	for _, node := range nodes {
+		if !g.HasNode(node) {
+			return fmt.Errorf("%w: %v", ErrNodeNotFound, node)
+		}
+	}
+	return nil
+}
+
+// requireEdge returns an error wrapping ErrNodeNotFound or ErrEdgeNotFound
+// unless g has an edge from from to to.
+func requireEdge[T comparable](g Traversable[T], from T, to T) error {
+	if err := requireNodes(g, from, to); err != nil {
+		return err
+	}
+	if !g.HasEdge(from, to) {
+		return fmt.Errorf("%w: %v -> %v", ErrEdgeNotFound, from, to)
+	}
+	return nil
+}
+
+// noPath returns an error wrapping ErrNoPath from source to target.
+func noPath[T comparable](source T, target T) error {
+	return fmt.Errorf("%w: %v -> %v", ErrNoPath, source, target)
+}
+
+// NewGraphChecked is NewGraph, returning an error wrapping ErrRepresentation
+// for CSR, which only Freeze builds, and for values that are not a
+// RepresentationType.
+func NewGraphChecked[T comparable](graphType GraphType, repType RepresentationType) (*Graph[T], error) {
+	if err := checkConversion(repType); err != nil {
+		return nil, err
+	}
+	return NewGraph[T](graphType, repType), nil
+}
+
+// NewWeightedGraphChecked is NewWeightedGraph, rejecting repType as
+// NewGraphChecked does.
+func NewWeightedGraphChecked[T comparable, W Number](graphType GraphType, repType RepresentationType) (*WeightedGraph[T, W], error) {
+	if err := checkConversion(repType); err != nil {
+		return nil, err
+	}
+	return NewWeightedGraph[T, W](graphType, repType), nil
+}
+
+// RemoveNodeChecked is RemoveNode, returning an error wrapping
+// ErrNodeNotFound instead of doing nothing when node is missing.
+func (g *Graph[T]) RemoveNodeChecked(node T) error {
+	if err := requireNodes[T](g, node); err != nil {
+		return err
+	}
+	g.RemoveNode(node)
+	return nil
+}
+
+// RemoveEdgeChecked is RemoveEdge, returning an error wrapping
+// ErrNodeNotFound or ErrEdgeNotFound instead of doing nothing when the edge
+// is missing.
+func (g *Graph[T]) RemoveEdgeChecked(from T, to T) error {
+	if err := requireEdge[T](g, from, to); err != nil {
+		return err
+	}
+	g.RemoveEdge(from, to)
+	return nil
+}
+
+func (g *Graph[T]) NeighboursChecked(node T) ([]T, error) {
+	if err := requireNodes[T](g, node); err != nil {
+		return nil, err
+	}
+	return g.Neighbours(node), nil
+}
+
+func (g *Graph[T]) BFSChecked(start T) ([]T, error) {
+	if err := requireNodes[T](g, start); err != nil {
+		return nil, err
+	}
+	return g.BFS(start), nil
+}
+
+func (g *Graph[T]) DFSRecursiveChecked(start T) ([]T, error) {
+	if err := requireNodes[T](g, start); err != nil {
+		return nil, err
+	}
+	return g.DFSRecursive(start), nil
+}
+
+func (g *Graph[T]) DFSIterativeChecked(start T) ([]T, error) {
+	if err := requireNodes[T](g, start); err != nil {
+		return nil, err
+	}
+	return g.DFSIterative(start), nil
+}
+
+func (g *Graph[T]) BFSShortestPathChecked(source T, target T) ([]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	path := g.BFSShortestPath(source, target)
+	if len(path) == 0 {
+		return nil, noPath(source, target)
+	}
+	return path, nil
+}
+
+func (g *Graph[T]) RecursiveDFSAllPathFindingChecked(source T, target T) ([][]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	paths := g.RecursiveDFSAllPathFinding(source, target)
+	if len(paths) == 0 {
+		return nil, noPath(source, target)
+	}
+	return paths, nil
+}
+
+func (g *Graph[T]) RecursiveDFSAnyPathFindingChecked(source T, target T) ([]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	path := g.RecursiveDFSAnyPathFinding(source, target)
+	if len(path) == 0 {
+		return nil, noPath(source, target)
+	}
+	return path, nil
+}
+
+func (g *Graph[T]) DFSIterativeAllPathFindingChecked(source T, target T) ([][]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	paths := g.DFSIterativeAllPathFinding(source, target)
+	if len(paths) == 0 {
+		return nil, noPath(source, target)
+	}
+	return paths, nil
+}
+
+func (g *Graph[T]) DFSIterativeAnyPathFindingChecked(source T, target T) ([]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	path := g.DFSIterativeAnyPathFinding(source, target)
+	if len(path) == 0 {
+		return nil, noPath(source, target)
+	}
+	return path, nil
+}
+
+func (g *WeightedGraph[T, W]) RemoveNodeChecked(node T) error {
+	if err := requireNodes[T](g, node); err != nil {
+		return err
+	}
+	g.RemoveNode(node)
+	return nil
+}
+
+func (g *WeightedGraph[T, W]) RemoveEdgeChecked(from T, to T) error {
+	if err := requireEdge[T](g, from, to); err != nil {
+		return err
+	}
+	g.RemoveEdge(from, to)
+	return nil
+}
+
+func (g *WeightedGraph[T, W]) NeighboursChecked(node T) ([]T, error) {
+	if err := requireNodes[T](g, node); err != nil {
+		return nil, err
+	}
+	return g.Neighbours(node), nil
+}
+
+func (g *WeightedGraph[T, W]) WeightChecked(from T, to T) (W, error) {
+	if err := requireEdge[T](g, from, to); err != nil {
+		return 0, err
+	}
+	weight, _ := g.Weight(from, to)
+	return weight, nil
+}
+
+func (g *WeightedGraph[T, W]) BFSChecked(start T) ([]T, error) {
+	if err := requireNodes[T](g, start); err != nil {
+		return nil, err
+	}
+	return g.BFS(start), nil
+}
+
+func (g *WeightedGraph[T, W]) DFSRecursiveChecked(start T) ([]T, error) {
+	if err := requireNodes[T](g, start); err != nil {
+		return nil, err
+	}
+	return g.DFSRecursive(start), nil
+}
+
+func (g *WeightedGraph[T, W]) DFSIterativeChecked(start T) ([]T, error) {
+	if err := requireNodes[T](g, start); err != nil {
+		return nil, err
+	}
+	return g.DFSIterative(start), nil
+}
+
+func (g *WeightedGraph[T, W]) BFSShortestPathChecked(source T, target T) ([]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	path := g.BFSShortestPath(source, target)
+	if len(path) == 0 {
+		return nil, noPath(source, target)
+	}
+	return path, nil
+}
+
+func (g *WeightedGraph[T, W]) RecursiveDFSAllPathFindingChecked(source T, target T) ([][]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	paths := g.RecursiveDFSAllPathFinding(source, target)
+	if len(paths) == 0 {
+		return nil, noPath(source, target)
+	}
+	return paths, nil
+}
+
+func (g *WeightedGraph[T, W]) RecursiveDFSAnyPathFindingChecked(source T, target T) ([]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	path := g.RecursiveDFSAnyPathFinding(source, target)
+	if len(path) == 0 {
+		return nil, noPath(source, target)
+	}
+	return path, nil
+}
+
+func (g *WeightedGraph[T, W]) DFSIterativeAllPathFindingChecked(source T, target T) ([][]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	paths := g.DFSIterativeAllPathFinding(source, target)
+	if len(paths) == 0 {
+		return nil, noPath(source, target)
+	}
+	return paths, nil
+}
+
+func (g *WeightedGraph[T, W]) DFSIterativeAnyPathFindingChecked(source T, target T) ([]T, error) {
+	if err := requireNodes[T](g, source, target); err != nil {
+		return nil, err
+	}
+	path := g.DFSIterativeAnyPathFinding(source, target)
+	if len(path) == 0 {
+		return nil, noPath(source, target)
+	}
+	return path, nil
+}
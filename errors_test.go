@@ -0,0 +1,154 @@
+package graph
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// pathGraph returns the directed graph 1->2->3, 1->3, 3->1 with the isolated
+// node 4.
+func pathGraph(repType RepresentationType) *Graph[int] {
+	g := NewGraph[int](Directed, repType)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(1, 3)
+	g.AddEdge(3, 1)
+	g.AddNode(4)
+	return g
+}
+
+func TestRemoveErrors(t *testing.T) {
+	for _, repType := range representations {
+		g := pathGraph(repType)
+		tests := []struct {
+			name string
+			err  error
+			want error
+		}{
+			{"missing node", g.RemoveNodeChecked(9), ErrNodeNotFound},
+			{"edge to missing node", g.RemoveEdgeChecked(1, 9), ErrNodeNotFound},
+			{"missing edge", g.RemoveEdgeChecked(2, 1), ErrEdgeNotFound},
+			{"existing edge", g.RemoveEdgeChecked(1, 2), nil},
+			{"removed edge", g.RemoveEdgeChecked(1, 2), ErrEdgeNotFound},
+			{"existing node", g.RemoveNodeChecked(4), nil},
+		}
+		for _, tt := range tests {
+			if !errors.Is(tt.err, tt.want) || (tt.want == nil && tt.err != nil) {
+				t.Errorf("%v/%s: got %v, want %v", repType, tt.name, tt.err, tt.want)
+			}
+		}
+		edges := len(g.Edges())
+		g.RemoveNode(9)
+		g.RemoveEdge(2, 1)
+		if len(g.Edges()) != edges || len(g.Nodes()) != 3 {
+			t.Errorf("%v: removing missing elements changed the graph to %v", repType, g.Edges())
+		}
+	}
+}
+
+func TestPathFindingChecked(t *testing.T) {
+	type anyPath func(g *Graph[int], source, target int) ([]int, error)
+	type allPaths func(g *Graph[int], source, target int) ([][]int, error)
+	anyFuncs := map[string]anyPath{
+		"BFSShortestPath":            (*Graph[int]).BFSShortestPathChecked,
+		"RecursiveDFSAnyPathFinding": (*Graph[int]).RecursiveDFSAnyPathFindingChecked,
+		"DFSIterativeAnyPathFinding": (*Graph[int]).DFSIterativeAnyPathFindingChecked,
+	}
+	allFuncs := map[string]allPaths{
+		"RecursiveDFSAllPathFinding": (*Graph[int]).RecursiveDFSAllPathFindingChecked,
+		"DFSIterativeAllPathFinding": (*Graph[int]).DFSIterativeAllPathFindingChecked,
+	}
+	tests := []struct {
+		name           string
+		source, target int
+		paths          [][]int
+		err            error
+	}{
+		{"two paths", 1, 3, [][]int{{1, 2, 3}, {1, 3}}, nil},
+		{"back edge", 3, 2, [][]int{{3, 1, 2}}, nil},
+		{"unreachable", 1, 4, nil, ErrNoPath},
+		{"unknown source", 9, 1, nil, ErrNodeNotFound},
+		{"unknown target", 1, 9, nil, ErrNodeNotFound},
+	}
+	for _, repType := range representations {
+		g := pathGraph(repType)
+		for _, tt := range tests {
+			for name, f := range anyFuncs {
+				path, err := f(g, tt.source, tt.target)
+				if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+					t.Errorf("%v/%s/%s: err = %v, want %v", repType, name, tt.name, err, tt.err)
+					continue
+				}
+				if tt.err == nil && !slices.ContainsFunc(tt.paths, func(p []int) bool { return slices.Equal(p, path) }) {
+					t.Errorf("%v/%s/%s: path %v, want one of %v", repType, name, tt.name, path, tt.paths)
+				}
+			}
+			for name, f := range allFuncs {
+				paths, err := f(g, tt.source, tt.target)
+				if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+					t.Errorf("%v/%s/%s: err = %v, want %v", repType, name, tt.name, err, tt.err)
+					continue
+				}
+				sortPaths(paths)
+				if tt.err == nil && !slices.EqualFunc(paths, tt.paths, slices.Equal) {
+					t.Errorf("%v/%s/%s: paths %v, want %v", repType, name, tt.name, paths, tt.paths)
+				}
+			}
+		}
+	}
+}
+
+func TestBFSShortestPathIsShortest(t *testing.T) {
+	for _, repType := range representations {
+		g := pathGraph(repType)
+		path, err := g.BFSShortestPathChecked(1, 3)
+		if err != nil || !slices.Equal(path, []int{1, 3}) {
+			t.Errorf("%v: BFSShortestPathChecked(1, 3) = %v, %v, want [1 3]", repType, path, err)
+		}
+	}
+}
+
+func TestTraversalChecked(t *testing.T) {
+	for _, repType := range representations {
+		g := NewWeightedGraph[int, int](Undirected, repType)
+		g.AddEdge(1, 2, 5)
+		g.AddEdge(2, 3, 5)
+		for name, f := range map[string]func(int) ([]int, error){
+			"BFS":          g.BFSChecked,
+			"DFSRecursive": g.DFSRecursiveChecked,
+			"DFSIterative": g.DFSIterativeChecked,
+		} {
+			order, err := f(1)
+			if err != nil || len(order) != 3 || order[0] != 1 {
+				t.Errorf("%v/%s(1) = %v, %v", repType, name, order, err)
+			}
+			if _, err := f(9); !errors.Is(err, ErrNodeNotFound) {
+				t.Errorf("%v/%s(9): err = %v, want ErrNodeNotFound", repType, name, err)
+			}
+		}
+		if _, err := g.WeightChecked(1, 3); !errors.Is(err, ErrEdgeNotFound) {
+			t.Errorf("%v: WeightChecked(1, 3): err = %v, want ErrEdgeNotFound", repType, err)
+		}
+		if path, err := g.DFSIterativeAnyPathFindingChecked(3, 1); err != nil || !slices.Equal(path, []int{3, 2, 1}) {
+			t.Errorf("%v: DFSIterativeAnyPathFindingChecked(3, 1) = %v, %v", repType, path, err)
+		}
+	}
+}
+
+func TestCycleErrorsUnwrap(t *testing.T) {
+	g := NewWeightedGraph[string, int](Directed, AdjacencyList)
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "a", -2)
+	if _, _, err := g.BellmanFord("a"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("BellmanFord: err = %v, want ErrNegativeWeight", err)
+	}
+	if _, err := g.TopologicalSort(); !errors.Is(err, ErrCycle) {
+		t.Errorf("TopologicalSort: err = %v, want ErrCycle", err)
+	}
+}
+
+// sortPaths orders paths lexicographically so results can be compared.
+func sortPaths(paths [][]int) {
+	slices.SortFunc(paths, slices.Compare)
+}
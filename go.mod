@@ -1,3 +1,3 @@
 module github.com/sidsrbh/graph
 
-go 1.21.1
+go 1.23
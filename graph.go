@@ -1,5 +1,7 @@
 package graph
 
+import "math"
+
 type GraphType int
 
 const (
@@ -12,10 +14,45 @@ type RepresentationType int
 const (
 	AdjacencyList RepresentationType = iota
 	AdjacencyMatrix
+	// Auto lets ConvertTo pick a representation from the edge density. New
+	// graphs created with Auto start as AdjacencyList.
+	Auto
+	// CSR is the read-only representation of a CSRGraph and is only produced
+	// by Freeze. NewGraphChecked, NewWeightedGraphChecked and ConvertTo
+	// reject it.
+	CSR
 )
 
+// INF is the int distance the package used to report for unreachable nodes.
+//
+// Deprecated: distances are now reported as Infinity[W]() for the graph's
+// weight type W.
 const INF = int(1e9)
 
+// Number is the set of types usable as edge weights. Unsigned integers are
+// left out because Bellman-Ford, Johnson and the flow algorithms subtract
+// weights and need negative values. Integer types narrower than 32 bits are
+// left out because path lengths and flow totals are summed in W without
+// overflow checks, so every sum the algorithms form must stay below
+// Infinity[W]().
+type Number interface {
+	~int | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// Infinity returns the distance reported for unreachable nodes: +Inf for
+// floating-point weights and the largest value of W otherwise.
+func Infinity[W Number]() W {
+	half := 0.5
+	if W(half) != 0 {
+		return W(math.Inf(1))
+	}
+	inf := W(1)
+	for inf*2+1 > inf {
+		inf = inf*2 + 1
+	}
+	return inf
+}
+
 type Graph[T comparable] struct {
 	graphType GraphType
 	repType   RepresentationType
@@ -29,24 +66,47 @@ type Graph[T comparable] struct {
 	nodesToIndex map[T]int
 	indexToNodes []T
 	adjMatrix    [][]bool
+
+	attrs attributes[T]
+
+	// shared is set once a Snapshot may hold the same storage
+	shared bool
+
+	// log backs transactions and undo; nil until either is first used
+	log *mutationLog
 }
 
-type WeightedGraph[T comparable] struct {
+type WeightedGraph[T comparable, W Number] struct {
 	graphType GraphType
 	repType   RepresentationType
 
 	nodes map[T]struct{}
 
 	//AdjacencyList
-	adjList map[T]map[T]int
+	adjList map[T]map[T]W
 
 	//Adjacency Matrix reated storage
 	nodesToIndex map[T]int
 	indexToNodes []T
-	adjMatrix    [][]int
+	adjMatrix    [][]W
+	hasEdge      [][]bool
+
+	attrs attributes[T]
+
+	// shared is set once a Snapshot may hold the same storage
+	shared bool
+
+	// log backs transactions and undo; nil until either is first used
+	log *mutationLog
 }
 
+// NewGraph returns an empty graph using repType. It does not check repType:
+// anything but AdjacencyMatrix, including CSR, gives an AdjacencyList graph.
+// Use NewGraphChecked to have CSR and unknown values rejected.
 func NewGraph[T comparable](graphType GraphType, repType RepresentationType) *Graph[T] {
+	if repType != AdjacencyMatrix {
+		repType = AdjacencyList
+	}
 	graph := &Graph[T]{
 		graphType:    graphType,
 		repType:      repType,
@@ -91,6 +151,10 @@ func (g *Graph[T]) RemoveEdge(from T, to T) {
 	}
 }
 
+func (g *Graph[T]) IsDirected() bool {
+	return g.graphType == Directed
+}
+
 func (g *Graph[T]) HasNode(node T) bool {
 	_, exists := g.nodes[node]
 	return exists
@@ -113,6 +177,9 @@ func (g *Graph[T]) Neighbours(node T) []T {
 }
 
 func (g *Graph[T]) Nodes() []T {
+	if g.repType == AdjacencyMatrix {
+		return append([]T{}, g.indexToNodes...)
+	}
 	elems := make([]T, 0, len(g.nodes))
 	for key, _ := range g.nodes {
 		elems = append(elems, key)
@@ -214,36 +281,50 @@ func (g *Graph[T]) BFSShortestPath(source T, target T) []T {
 	}
 }
 
+// HasCycleDirected reports whether following the edges leads back to a
+// node. Each edge of an undirected graph is a cycle of two, and a self-loop
+// is a cycle of one. It replaces HasCycleDirectedAdjList and
+// HasCycleDirectedAdjMatrix and answers as they did.
 func (g *Graph[T]) HasCycleDirected() bool {
-	if g.repType == AdjacencyList {
-		return g.HasCycleDirectedAdjList()
-	} else {
-		return g.HasCycleDirectedAdjMatrix()
-	}
+	return hasCycle(g.Nodes(), g.Neighbours, true)
 }
 
+// HasCycleUndirected reports whether the graph has a cycle when edge
+// directions are ignored; a pair of opposite directed edges does not count.
+//
+// It replaces HasCycleUndirectedAdjList and HasCycleUndirectedAdjMatrix but
+// answers differently in two cases: every self-loop is a cycle, where the
+// adjacency list version missed one on the node its search started from, and
+// a directed graph is searched as if it were undirected, where the old
+// versions followed edge directions (the matrix version reported false).
 func (g *Graph[T]) HasCycleUndirected() bool {
-	if g.repType == AdjacencyList {
-		return g.HasCycleUndirectedAdjList()
-	} else {
-		return g.HasCycleUndirectedAdjMatrix()
+	nodes := g.Nodes()
+	if g.IsDirected() {
+		return hasCycle(nodes, ignoreDirection(nodes, g.Neighbours), false)
 	}
+	return hasCycle(nodes, g.Neighbours, false)
 }
 
-func NewWeightedGraph[T comparable](graphType GraphType, repType RepresentationType) *WeightedGraph[T] {
-	graph := &WeightedGraph[T]{
+// NewWeightedGraph returns an empty weighted graph using repType, which is
+// not checked, as in NewGraph. Use NewWeightedGraphChecked to have it checked.
+func NewWeightedGraph[T comparable, W Number](graphType GraphType, repType RepresentationType) *WeightedGraph[T, W] {
+	if repType != AdjacencyMatrix {
+		repType = AdjacencyList
+	}
+	graph := &WeightedGraph[T, W]{
 		graphType:    graphType,
 		repType:      repType,
 		nodes:        make(map[T]struct{}),
-		adjList:      make(map[T]map[T]int),
+		adjList:      make(map[T]map[T]W),
 		nodesToIndex: make(map[T]int),
 		indexToNodes: []T{},
-		adjMatrix:    [][]int{},
+		adjMatrix:    [][]W{},
+		hasEdge:      [][]bool{},
 	}
 	return graph
 }
 
-func (g *WeightedGraph[T]) AddNode(node T) {
+func (g *WeightedGraph[T, W]) AddNode(node T) {
 	if g.repType == AdjacencyList {
 		g.AddNodeAdjList(node)
 	} else {
@@ -251,7 +332,7 @@ func (g *WeightedGraph[T]) AddNode(node T) {
 	}
 }
 
-func (g *WeightedGraph[T]) RemoveNode(node T) {
+func (g *WeightedGraph[T, W]) RemoveNode(node T) {
 	if g.repType == AdjacencyList {
 		g.RemoveNodeAdjList(node)
 	} else {
@@ -259,7 +340,7 @@ func (g *WeightedGraph[T]) RemoveNode(node T) {
 	}
 }
 
-func (g *WeightedGraph[T]) AddEdge(from T, to T, weight int) {
+func (g *WeightedGraph[T, W]) AddEdge(from T, to T, weight W) {
 	if g.repType == AdjacencyList {
 		g.AddEdgeAdjList(from, to, weight)
 	} else {
@@ -267,7 +348,7 @@ func (g *WeightedGraph[T]) AddEdge(from T, to T, weight int) {
 	}
 }
 
-func (g *WeightedGraph[T]) RemoveEdge(from T, to T) {
+func (g *WeightedGraph[T, W]) RemoveEdge(from T, to T) {
 	if g.repType == AdjacencyList {
 		g.RemoveEdgeAdjList(from, to)
 	} else {
@@ -275,12 +356,16 @@ func (g *WeightedGraph[T]) RemoveEdge(from T, to T) {
 	}
 }
 
-func (g *WeightedGraph[T]) HasNode(node T) bool {
+func (g *WeightedGraph[T, W]) IsDirected() bool {
+	return g.graphType == Directed
+}
+
+func (g *WeightedGraph[T, W]) HasNode(node T) bool {
 	_, exists := g.nodes[node]
 	return exists
 }
 
-func (g *WeightedGraph[T]) HasEdge(from T, to T) bool {
+func (g *WeightedGraph[T, W]) HasEdge(from T, to T) bool {
 	if g.repType == AdjacencyList {
 		return g.HasEdgeAdjList(from, to)
 	} else {
@@ -288,7 +373,15 @@ func (g *WeightedGraph[T]) HasEdge(from T, to T) bool {
 	}
 }
 
-func (g *WeightedGraph[T]) Neighbours(node T) []T {
+func (g *WeightedGraph[T, W]) Weight(from T, to T) (W, bool) {
+	if g.repType == AdjacencyList {
+		return g.WeightAdjList(from, to)
+	} else {
+		return g.WeightAdjMatrix(from, to)
+	}
+}
+
+func (g *WeightedGraph[T, W]) Neighbours(node T) []T {
 	if g.repType == AdjacencyList {
 		return g.NeighboursAdjList(node)
 	} else {
@@ -296,7 +389,10 @@ func (g *WeightedGraph[T]) Neighbours(node T) []T {
 	}
 }
 
-func (g *WeightedGraph[T]) Nodes() []T {
+func (g *WeightedGraph[T, W]) Nodes() []T {
+	if g.repType == AdjacencyMatrix {
+		return append([]T{}, g.indexToNodes...)
+	}
 	elems := make([]T, 0, len(g.nodes))
 	for key, _ := range g.nodes {
 		elems = append(elems, key)
@@ -304,7 +400,7 @@ func (g *WeightedGraph[T]) Nodes() []T {
 	return elems
 }
 
-func (g *WeightedGraph[T]) Edges() []WeightedEdge[T] {
+func (g *WeightedGraph[T, W]) Edges() []WeightedEdge[T, W] {
 	if g.repType == AdjacencyList {
 		return g.EdgesAdjList()
 	} else {
@@ -312,7 +408,7 @@ func (g *WeightedGraph[T]) Edges() []WeightedEdge[T] {
 	}
 }
 
-func (g *WeightedGraph[T]) OutDegree(node T) int {
+func (g *WeightedGraph[T, W]) OutDegree(node T) int {
 	if g.repType == AdjacencyList {
 		return g.OutDegreeAdjList(node)
 	} else {
@@ -320,7 +416,7 @@ func (g *WeightedGraph[T]) OutDegree(node T) int {
 	}
 }
 
-func (g *WeightedGraph[T]) InDegree(node T) int {
+func (g *WeightedGraph[T, W]) InDegree(node T) int {
 	if g.repType == AdjacencyList {
 		return g.InDegreeAdjList(node)
 	} else {
@@ -328,11 +424,11 @@ func (g *WeightedGraph[T]) InDegree(node T) int {
 	}
 }
 
-func (g *WeightedGraph[T]) Degree(node T) int {
+func (g *WeightedGraph[T, W]) Degree(node T) int {
 	return g.OutDegree(node)
 }
 
-func (g *WeightedGraph[T]) BFS(start T) []T {
+func (g *WeightedGraph[T, W]) BFS(start T) []T {
 	if g.repType == AdjacencyList {
 		return g.BFSAdjList(start)
 	} else {
@@ -340,7 +436,7 @@ func (g *WeightedGraph[T]) BFS(start T) []T {
 	}
 }
 
-func (g *WeightedGraph[T]) DFSRecursive(start T) []T {
+func (g *WeightedGraph[T, W]) DFSRecursive(start T) []T {
 
 	if g.repType == AdjacencyList {
 		return g.DFSRecursiveAdjList(start)
@@ -349,7 +445,7 @@ func (g *WeightedGraph[T]) DFSRecursive(start T) []T {
 	}
 }
 
-func (g *WeightedGraph[T]) DFSIterative(start T) []T {
+func (g *WeightedGraph[T, W]) DFSIterative(start T) []T {
 	if g.repType == AdjacencyList {
 		return g.DFSIterativeAdjList(start)
 	} else {
@@ -357,7 +453,7 @@ func (g *WeightedGraph[T]) DFSIterative(start T) []T {
 	}
 }
 
-func (g *WeightedGraph[T]) RecursiveDFSAllPathFinding(source T, target T) [][]T {
+func (g *WeightedGraph[T, W]) RecursiveDFSAllPathFinding(source T, target T) [][]T {
 	if g.repType == AdjacencyList {
 		return g.RecursiveDFSAllPathFindingAdjList(source, target)
 	} else {
@@ -365,7 +461,7 @@ func (g *WeightedGraph[T]) RecursiveDFSAllPathFinding(source T, target T) [][]T
 	}
 }
 
-func (g *WeightedGraph[T]) RecursiveDFSAnyPathFinding(source T, target T) []T {
+func (g *WeightedGraph[T, W]) RecursiveDFSAnyPathFinding(source T, target T) []T {
 	if g.repType == AdjacencyList {
 		return g.RecursiveDFSAnyPathFindingAdjList(source, target)
 	} else {
@@ -373,7 +469,7 @@ func (g *WeightedGraph[T]) RecursiveDFSAnyPathFinding(source T, target T) []T {
 	}
 }
 
-func (g *WeightedGraph[T]) DFSIterativeAllPathFinding(source T, target T) [][]T {
+func (g *WeightedGraph[T, W]) DFSIterativeAllPathFinding(source T, target T) [][]T {
 	if g.repType == AdjacencyList {
 		return g.DFSIterativeAllPathFindingAdjList(source, target)
 	} else {
@@ -382,7 +478,7 @@ func (g *WeightedGraph[T]) DFSIterativeAllPathFinding(source T, target T) [][]T
 
 }
 
-func (g *WeightedGraph[T]) DFSIterativeAnyPathFinding(source T, target T) []T {
+func (g *WeightedGraph[T, W]) DFSIterativeAnyPathFinding(source T, target T) []T {
 	if g.repType == AdjacencyList {
 		return g.DFSIterativeAnyPathFindingAdjList(source, target)
 	} else {
@@ -390,7 +486,7 @@ func (g *WeightedGraph[T]) DFSIterativeAnyPathFinding(source T, target T) []T {
 	}
 }
 
-func (g *WeightedGraph[T]) BFSShortestPath(source T, target T) []T {
+func (g *WeightedGraph[T, W]) BFSShortestPath(source T, target T) []T {
 	if g.repType == AdjacencyList {
 		return g.BFSShortestPathAdjList(source, target)
 	} else {
@@ -398,18 +494,14 @@ func (g *WeightedGraph[T]) BFSShortestPath(source T, target T) []T {
 	}
 }
 
-func (g *WeightedGraph[T]) HasCycleDirected() bool {
-	if g.repType == AdjacencyList {
-		return g.HasCycleDirectedAdjList()
-	} else {
-		return g.HasCycleDirectedAdjMatrix()
-	}
+func (g *WeightedGraph[T, W]) HasCycleDirected() bool {
+	return hasCycle(g.Nodes(), g.Neighbours, true)
 }
 
-func (g *WeightedGraph[T]) HasCycleUndirected() bool {
-	if g.repType == AdjacencyList {
-		return g.HasCycleUndirectedAdjList()
-	} else {
-		return g.HasCycleUndirectedAdjMatrix()
+func (g *WeightedGraph[T, W]) HasCycleUndirected() bool {
+	nodes := g.Nodes()
+	if g.IsDirected() {
+		return hasCycle(nodes, ignoreDirection(nodes, g.Neighbours), false)
 	}
+	return hasCycle(nodes, g.Neighbours, false)
 }
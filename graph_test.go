@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWeightValuesAreEdges(t *testing.T) {
+	for _, repType := range representations {
+		g := NewWeightedGraph[string, int](Directed, repType)
+		g.AddEdge("a", "b", INF)
+		g.AddEdge("b", "c", 0)
+		g.AddEdge("c", "a", -7)
+		for _, e := range []WeightedEdge[string, int]{{Edge: [2]string{"a", "b"}, Weight: INF}, {Edge: [2]string{"b", "c"}, Weight: 0}, {Edge: [2]string{"c", "a"}, Weight: -7}} {
+			if w, ok := g.Weight(e.Edge[0], e.Edge[1]); !ok || w != e.Weight {
+				t.Errorf("%v: Weight%v = %d, %v, want %d", repType, e.Edge, w, ok, e.Weight)
+			}
+		}
+		if len(g.Edges()) != 3 || len(g.Neighbours("b")) != 1 {
+			t.Errorf("%v: edges %v", repType, g.Edges())
+		}
+		if _, ok := g.Weight("b", "a"); ok {
+			t.Errorf("%v: missing edge reported present", repType)
+		}
+	}
+}
+
+func TestGenericWeights(t *testing.T) {
+	for _, repType := range representations {
+		f := NewWeightedGraph[string, float32](Undirected, repType)
+		f.AddEdge("a", "b", 0.5)
+		f.AddEdge("b", "c", 0.25)
+		f.AddEdge("a", "c", 1)
+		if _, cost := f.DijkstraShortestPath("a", "c"); cost != 0.75 {
+			t.Errorf("%v: float32 Dijkstra cost %v, want 0.75", repType, cost)
+		}
+		if _, total := f.Kruskal(); total != 0.75 {
+			t.Errorf("%v: float32 Kruskal weighs %v, want 0.75", repType, total)
+		}
+
+		small := NewWeightedGraph[int, int32](Directed, repType)
+		small.AddEdge(1, 2, 100)
+		small.AddEdge(2, 3, -50)
+		small.AddNode(4)
+		dist, _, err := small.BellmanFord(1)
+		if err != nil || dist[3] != 50 || dist[4] != math.MaxInt32 {
+			t.Errorf("%v: int32 BellmanFord = %v, %v", repType, dist, err)
+		}
+
+		inf := NewWeightedGraph[string, float64](Directed, repType)
+		inf.AddEdge("s", "t", math.Inf(1))
+		if w, ok := inf.Weight("s", "t"); !ok || !math.IsInf(w, 1) {
+			t.Errorf("%v: +Inf weight stored as %v, %v", repType, w, ok)
+		}
+	}
+}
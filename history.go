@@ -0,0 +1,451 @@
+package graph
+
+import "slices"
+
+// change is one recorded mutation: undo reverts it and redo applies it again.
+type change struct {
+	undo func()
+	redo func()
+}
+
+// mutationLog records the node and edge adds and removes of a graph while a
+// transaction is open or undo is enabled. Its nil value records nothing.
+type mutationLog struct {
+	undoEnabled bool
+	limit       int        // undo steps kept, 0 for all
+	undo        [][]change // committed steps, oldest first
+	redo        [][]change // undone steps, most recently undone last
+	pending     []change   // changes of the open transaction
+	savepoints  []int      // len(pending) at each open Begin
+	replaying   bool
+}
+
+func (l *mutationLog) recording() bool {
+	return l != nil && !l.replaying && (l.undoEnabled || len(l.savepoints) > 0)
+}
+
+// record appends c to the open transaction, or makes it an undo step of its
+// own outside one. A new change discards the steps that could be redone.
+func (l *mutationLog) record(c change) {
+	l.redo = nil
+	l.pending = append(l.pending, c)
+	if len(l.savepoints) == 0 {
+		l.finish()
+	}
+}
+
+// group makes the changes recorded until the returned function is called one
+// undo step, so that AddEdge and the nodes it adds are undone together.
+func (l *mutationLog) group() func() {
+	if !l.recording() {
+		return func() {}
+	}
+	l.begin()
+	return func() { l.commit() }
+}
+
+// finish turns the pending changes into one undo step.
+func (l *mutationLog) finish() {
+	if l.undoEnabled && len(l.pending) > 0 {
+		l.undo = append(l.undo, l.pending)
+		if l.limit > 0 && len(l.undo) > l.limit {
+			l.undo = slices.Delete(l.undo, 0, len(l.undo)-l.limit)
+		}
+	}
+	l.pending = nil
+}
+
+// replay reverts changes newest first, or applies them again oldest first,
+// without recording what it does.
+func (l *mutationLog) replay(changes []change, revert bool) {
+	l.replaying = true
+	defer func() { l.replaying = false }()
+	if revert {
+		for i := len(changes) - 1; i >= 0; i-- {
+			changes[i].undo()
+		}
+		return
+	}
+	for _, c := range changes {
+		c.redo()
+	}
+}
+
+func (l *mutationLog) enableUndo(limit int) {
+	l.undoEnabled = true
+	l.limit = max(limit, 0)
+}
+
+func (l *mutationLog) disableUndo() {
+	if l == nil {
+		return
+	}
+	l.undoEnabled = false
+	l.undo = nil
+	l.redo = nil
+}
+
+func (l *mutationLog) begin() {
+	l.savepoints = append(l.savepoints, len(l.pending))
+}
+
+func (l *mutationLog) commit() error {
+	if l == nil || len(l.savepoints) == 0 {
+		return ErrNoTransaction
+	}
+	l.savepoints = l.savepoints[:len(l.savepoints)-1]
+	if len(l.savepoints) == 0 {
+		l.finish()
+	}
+	return nil
+}
+
+func (l *mutationLog) rollback() error {
+	if l == nil || len(l.savepoints) == 0 {
+		return ErrNoTransaction
+	}
+	savepoint := l.savepoints[len(l.savepoints)-1]
+	l.savepoints = l.savepoints[:len(l.savepoints)-1]
+	l.replay(l.pending[savepoint:], true)
+	l.pending = l.pending[:savepoint]
+	return nil
+}
+
+func (l *mutationLog) canUndo() bool {
+	return l != nil && len(l.savepoints) == 0 && len(l.undo) > 0
+}
+
+func (l *mutationLog) canRedo() bool {
+	return l != nil && len(l.savepoints) == 0 && len(l.redo) > 0
+}
+
+func (l *mutationLog) undoStep() bool {
+	if !l.canUndo() {
+		return false
+	}
+	step := l.undo[len(l.undo)-1]
+	l.undo = l.undo[:len(l.undo)-1]
+	l.replay(step, true)
+	l.redo = append(l.redo, step)
+	return true
+}
+
+func (l *mutationLog) redoStep() bool {
+	if !l.canRedo() {
+		return false
+	}
+	step := l.redo[len(l.redo)-1]
+	l.redo = l.redo[:len(l.redo)-1]
+	l.replay(step, false)
+	l.undo = append(l.undo, step)
+	return true
+}
+
+// EnableUndo starts recording every node and edge add and remove on an undo
+// stack, keeping the most recent limit steps, or all of them if limit is 0.
+// A call made outside a transaction is one step, and a committed transaction
+// is one step however many changes it made. Changes are recorded by the
+// storage methods, so AddEdgeAdjList, RemoveNodeAdjMatrix and the like are
+// undone just as AddEdge and RemoveNode are. Attribute changes are not
+// recorded, but attributes dropped with a node or edge come back with it.
+func (g *Graph[T]) EnableUndo(limit int) {
+	g.history().enableUndo(limit)
+}
+
+// DisableUndo stops recording undo steps and discards the undo and redo
+// stacks. An open transaction can still be rolled back.
+func (g *Graph[T]) DisableUndo() {
+	g.log.disableUndo()
+}
+
+// Begin opens a transaction. Transactions nest: Rollback reverts the changes
+// made since the matching Begin, and the outermost Commit makes the whole
+// transaction a single undo step. Undo and Redo do nothing while one is open.
+func (g *Graph[T]) Begin() {
+	g.history().begin()
+}
+
+// Commit closes the innermost transaction, keeping its changes. It returns
+// ErrNoTransaction if no transaction is open.
+func (g *Graph[T]) Commit() error {
+	return g.log.commit()
+}
+
+// Rollback closes the innermost transaction and reverts its changes,
+// restoring removed nodes at their matrix index. It returns ErrNoTransaction
+// if no transaction is open.
+func (g *Graph[T]) Rollback() error {
+	return g.log.rollback()
+}
+
+// Undo reverts the most recent undo step and reports whether there was one.
+func (g *Graph[T]) Undo() bool {
+	return g.log.undoStep()
+}
+
+// Redo applies the most recently undone step again and reports whether there
+// was one. Any new change clears the steps that could be redone.
+func (g *Graph[T]) Redo() bool {
+	return g.log.redoStep()
+}
+
+func (g *Graph[T]) CanUndo() bool {
+	return g.log.canUndo()
+}
+
+func (g *Graph[T]) CanRedo() bool {
+	return g.log.canRedo()
+}
+
+func (g *Graph[T]) history() *mutationLog {
+	if g.log == nil {
+		g.log = &mutationLog{}
+	}
+	return g.log
+}
+
+func (g *Graph[T]) recordAddNode(node T) {
+	if !g.log.recording() || g.HasNode(node) {
+		return
+	}
+	g.log.record(change{
+		undo: func() { g.RemoveNode(node) },
+		redo: func() { g.AddNode(node) },
+	})
+}
+
+func (g *Graph[T]) recordRemoveNode(node T) {
+	if !g.log.recording() {
+		return
+	}
+	index := g.matrixIndex(node)
+	edges := incidentEdges[T](g, node)
+	attrs := g.attrs.nodeEntries(node)
+	g.log.record(change{
+		undo: func() {
+			g.insertNode(node, index)
+			for _, e := range edges {
+				g.AddEdge(e[0], e[1])
+			}
+			g.attrs.restore(attrs)
+		},
+		redo: func() { g.RemoveNode(node) },
+	})
+}
+
+// recordAddEdge is called once both ends are in the graph; adding them was
+// recorded on its own.
+func (g *Graph[T]) recordAddEdge(from T, to T) {
+	if !g.log.recording() || g.HasEdge(from, to) {
+		return
+	}
+	g.log.record(change{
+		undo: func() { g.RemoveEdge(from, to) },
+		redo: func() { g.AddEdge(from, to) },
+	})
+}
+
+func (g *Graph[T]) recordRemoveEdge(from T, to T) {
+	if !g.log.recording() || !g.HasEdge(from, to) {
+		return
+	}
+	attrs := g.attrs.edgeEntries(from, to, g.IsDirected())
+	g.log.record(change{
+		undo: func() {
+			g.AddEdge(from, to)
+			g.attrs.restore(attrs)
+		},
+		redo: func() { g.RemoveEdge(from, to) },
+	})
+}
+
+// matrixIndex returns the index of node in the adjacency matrix, or -1 for
+// adjacency list graphs.
+func (g *Graph[T]) matrixIndex(node T) int {
+	if g.repType != AdjacencyMatrix {
+		return -1
+	}
+	return g.nodesToIndex[node]
+}
+
+// insertNode adds node back at index in the adjacency matrix, moving the
+// nodes from index on one place up, so that undoing RemoveNodeAdjMatrix
+// restores the original order. Adjacency list graphs and an index of -1 add
+// the node as AddNode does.
+func (g *Graph[T]) insertNode(node T, index int) {
+	g.AddNode(node)
+	if g.repType != AdjacencyMatrix || index < 0 || index >= len(g.indexToNodes)-1 {
+		return
+	}
+	moveLast(g.indexToNodes, index)
+	moveLast(g.adjMatrix, index)
+	for _, row := range g.adjMatrix {
+		moveLast(row, index)
+	}
+	for i := index; i < len(g.indexToNodes); i++ {
+		g.nodesToIndex[g.indexToNodes[i]] = i
+	}
+}
+
+func (g *WeightedGraph[T, W]) EnableUndo(limit int) {
+	g.history().enableUndo(limit)
+}
+
+func (g *WeightedGraph[T, W]) DisableUndo() {
+	g.log.disableUndo()
+}
+
+func (g *WeightedGraph[T, W]) Begin() {
+	g.history().begin()
+}
+
+func (g *WeightedGraph[T, W]) Commit() error {
+	return g.log.commit()
+}
+
+func (g *WeightedGraph[T, W]) Rollback() error {
+	return g.log.rollback()
+}
+
+func (g *WeightedGraph[T, W]) Undo() bool {
+	return g.log.undoStep()
+}
+
+func (g *WeightedGraph[T, W]) Redo() bool {
+	return g.log.redoStep()
+}
+
+func (g *WeightedGraph[T, W]) CanUndo() bool {
+	return g.log.canUndo()
+}
+
+func (g *WeightedGraph[T, W]) CanRedo() bool {
+	return g.log.canRedo()
+}
+
+func (g *WeightedGraph[T, W]) history() *mutationLog {
+	if g.log == nil {
+		g.log = &mutationLog{}
+	}
+	return g.log
+}
+
+func (g *WeightedGraph[T, W]) recordAddNode(node T) {
+	if !g.log.recording() || g.HasNode(node) {
+		return
+	}
+	g.log.record(change{
+		undo: func() { g.RemoveNode(node) },
+		redo: func() { g.AddNode(node) },
+	})
+}
+
+func (g *WeightedGraph[T, W]) recordRemoveNode(node T) {
+	if !g.log.recording() {
+		return
+	}
+	index := g.matrixIndex(node)
+	edges := []WeightedEdge[T, W]{}
+	for _, e := range incidentEdges[T](g, node) {
+		weight, _ := g.Weight(e[0], e[1])
+		edges = append(edges, WeightedEdge[T, W]{Edge: e, Weight: weight})
+	}
+	attrs := g.attrs.nodeEntries(node)
+	g.log.record(change{
+		undo: func() {
+			g.insertNode(node, index)
+			for _, e := range edges {
+				g.AddEdge(e.Edge[0], e.Edge[1], e.Weight)
+			}
+			g.attrs.restore(attrs)
+		},
+		redo: func() { g.RemoveNode(node) },
+	})
+}
+
+// recordAddEdge also records the weight AddEdge overwrites when the edge is
+// already there.
+func (g *WeightedGraph[T, W]) recordAddEdge(from T, to T, weight W) {
+	if !g.log.recording() {
+		return
+	}
+	old, hadEdge := g.Weight(from, to)
+	if hadEdge && old == weight {
+		return
+	}
+	g.log.record(change{
+		undo: func() {
+			if hadEdge {
+				g.AddEdge(from, to, old)
+				return
+			}
+			g.RemoveEdge(from, to)
+		},
+		redo: func() { g.AddEdge(from, to, weight) },
+	})
+}
+
+func (g *WeightedGraph[T, W]) recordRemoveEdge(from T, to T) {
+	if !g.log.recording() || !g.HasEdge(from, to) {
+		return
+	}
+	weight, _ := g.Weight(from, to)
+	attrs := g.attrs.edgeEntries(from, to, g.IsDirected())
+	g.log.record(change{
+		undo: func() {
+			g.AddEdge(from, to, weight)
+			g.attrs.restore(attrs)
+		},
+		redo: func() { g.RemoveEdge(from, to) },
+	})
+}
+
+func (g *WeightedGraph[T, W]) matrixIndex(node T) int {
+	if g.repType != AdjacencyMatrix {
+		return -1
+	}
+	return g.nodesToIndex[node]
+}
+
+func (g *WeightedGraph[T, W]) insertNode(node T, index int) {
+	g.AddNode(node)
+	if g.repType != AdjacencyMatrix || index < 0 || index >= len(g.indexToNodes)-1 {
+		return
+	}
+	moveLast(g.indexToNodes, index)
+	moveLast(g.adjMatrix, index)
+	moveLast(g.hasEdge, index)
+	for i := range g.adjMatrix {
+		moveLast(g.adjMatrix[i], index)
+		moveLast(g.hasEdge[i], index)
+	}
+	for i := index; i < len(g.indexToNodes); i++ {
+		g.nodesToIndex[g.indexToNodes[i]] = i
+	}
+}
+
+// incidentEdges returns every edge touching node, each once, as RemoveNode
+// would drop them.
+func incidentEdges[T comparable](g Traversable[T], node T) [][2]T {
+	edges := [][2]T{}
+	for _, nbr := range g.Neighbours(node) {
+		edges = append(edges, [2]T{node, nbr})
+	}
+	if !g.IsDirected() {
+		return edges
+	}
+	for _, other := range g.Nodes() {
+		if other != node && g.HasEdge(other, node) {
+			edges = append(edges, [2]T{other, node})
+		}
+	}
+	return edges
+}
+
+// moveLast moves the last element of s to index, shifting the elements from
+// index on one place up.
+func moveLast[E any](s []E, index int) {
+	last := s[len(s)-1]
+	copy(s[index+1:], s[index:len(s)-1])
+	s[index] = last
+}
@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUndoRedo(t *testing.T) {
+	mutations := []struct {
+		name   string
+		mutate func(g *Graph[string])
+	}{
+		{"AddNode", func(g *Graph[string]) { g.AddNode("z") }},
+		{"AddEdge with new nodes", func(g *Graph[string]) { g.AddEdge("x", "y") }},
+		{"AddEdge self-loop", func(g *Graph[string]) { g.AddEdge("q", "q") }},
+		{"RemoveEdge", func(g *Graph[string]) { g.RemoveEdge("a", "b") }},
+		{"RemoveNode", func(g *Graph[string]) { g.RemoveNode("b") }},
+		{"RemoveNode first", func(g *Graph[string]) { g.RemoveNode("a") }},
+	}
+	for _, graphType := range []GraphType{Directed, Undirected} {
+		for _, repType := range representations {
+			for _, m := range mutations {
+				g := NewGraph[string](graphType, repType)
+				g.AddEdge("a", "b")
+				g.AddEdge("b", "c")
+				g.AddEdge("c", "a")
+				SetEdgeAttr(g, "a", "b", "k", 1)
+				g.EnableUndo(0)
+				nodes, edges := graphState(g)
+
+				m.mutate(g)
+				afterNodes, afterEdges := graphState(g)
+				label := m.name + "/" + map[GraphType]string{Directed: "directed", Undirected: "undirected"}[graphType]
+				if !g.Undo() {
+					t.Fatalf("%s: nothing to undo", label)
+				}
+				sameState(t, label+" undone", g, nodes, edges)
+				if v, ok := EdgeAttr[int](g, "a", "b", "k"); !ok || v != 1 {
+					t.Errorf("%s: edge attribute not restored", label)
+				}
+				if g.CanUndo() {
+					t.Errorf("%s: one call left more than one undo step", label)
+				}
+				if !g.Redo() {
+					t.Fatalf("%s: nothing to redo", label)
+				}
+				sameState(t, label+" redone", g, afterNodes, afterEdges)
+			}
+		}
+	}
+}
+
+func TestUndoStorageLevelMutations(t *testing.T) {
+	g := NewGraph[string](Directed, AdjacencyMatrix)
+	for _, n := range []string{"a", "b", "c", "d"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("a", "c")
+	g.AddEdge("d", "b")
+	g.EnableUndo(0)
+	nodes, edges := graphState(g)
+
+	g.RemoveNodeAdjMatrix("b")
+	g.AddEdgeAdjMatrix("c", "e")
+	g.RemoveNodeAdjMatrix("a")
+	g.RemoveEdgeAdjMatrix("c", "e")
+	for g.Undo() {
+	}
+	sameState(t, "undone", g, nodes, edges)
+
+	w := NewWeightedGraph[int, int](Directed, AdjacencyList)
+	w.AddEdge(1, 2, 5)
+	w.EnableUndo(0)
+	w.AddEdgeAdjList(1, 2, 9)
+	w.RemoveNodeAdjList(2)
+	w.Undo()
+	w.Undo()
+	if weight, ok := w.Weight(1, 2); !ok || weight != 5 {
+		t.Errorf("weighted: Weight(1, 2) = %d, %v after undo, want 5", weight, ok)
+	}
+}
+
+func TestTransactions(t *testing.T) {
+	for _, repType := range representations {
+		g := NewWeightedGraph[string, int](Undirected, repType)
+		g.AddEdge("a", "b", 1)
+
+		g.Begin()
+		g.AddEdge("b", "c", 2)
+		g.Begin()
+		g.RemoveNode("a")
+		if err := g.Rollback(); err != nil {
+			t.Fatal(err)
+		}
+		if !g.HasNode("a") || !g.HasEdge("a", "b") {
+			t.Errorf("%v: inner rollback did not restore a", repType)
+		}
+		if !g.HasEdge("b", "c") {
+			t.Errorf("%v: inner rollback undid the outer transaction", repType)
+		}
+		if err := g.Rollback(); err != nil {
+			t.Fatal(err)
+		}
+		if g.HasNode("c") {
+			t.Errorf("%v: outer rollback kept c", repType)
+		}
+		if err := g.Commit(); !errors.Is(err, ErrNoTransaction) {
+			t.Errorf("%v: Commit without a transaction: err = %v", repType, err)
+		}
+
+		g.EnableUndo(2)
+		g.Begin()
+		g.AddEdge("c", "d", 3)
+		g.AddEdge("d", "e", 4)
+		if g.CanUndo() {
+			t.Errorf("%v: undo allowed inside a transaction", repType)
+		}
+		g.Commit()
+		g.AddNode("x")
+		g.AddNode("y")
+		steps := 0
+		for g.Undo() {
+			steps++
+		}
+		if steps != 2 {
+			t.Errorf("%v: undid %d steps with a limit of 2", repType, steps)
+		}
+		if !g.HasNode("c") || g.HasNode("x") {
+			t.Errorf("%v: wrong steps undone", repType)
+		}
+		g.AddNode("w")
+		if g.CanRedo() {
+			t.Errorf("%v: a new change kept the redo stack", repType)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package graph
+
+// Traversable is the read-only view of a graph that the algorithms in this
+// package work on. Graph and WeightedGraph implement it, and so can any other
+// storage backend. For undirected graphs Neighbours must be symmetric.
+type Traversable[T comparable] interface {
+	Nodes() []T
+	HasNode(node T) bool
+	HasEdge(from T, to T) bool
+	Neighbours(node T) []T
+	IsDirected() bool
+}
+
+// WeightedTraversable is a Traversable whose edges carry a weight of type W.
+// Weight reports false if there is no edge from from to to.
+type WeightedTraversable[T comparable, W Number] interface {
+	Traversable[T]
+	Weight(from T, to T) (W, bool)
+}
+
+var (
+	_ Traversable[int]                  = (*Graph[int])(nil)
+	_ WeightedTraversable[int, float64] = (*WeightedGraph[int, float64])(nil)
+)
+
+// multiEdged is implemented by graphs whose Neighbours lists a node once
+// however many parallel edges lead to it. edgeNeighbours lists the far end of
+// every edge leaving node instead, once per edge.
+type multiEdged[T comparable] interface {
+	edgeNeighbours(node T) []T
+}
+
+// edgeNeighboursOf returns the neighbour function of g that repeats a node
+// for each parallel edge, so that depth-first searches see every edge.
+func edgeNeighboursOf[T comparable](g Traversable[T]) func(T) []T {
+	if m, ok := g.(multiEdged[T]); ok {
+		return m.edgeNeighbours
+	}
+	return g.Neighbours
+}
+
+// arcs lists every edge in each direction it can be followed, so undirected
+// edges appear twice.
+func arcs[T comparable, W Number](g WeightedTraversable[T, W]) []WeightedEdge[T, W] {
+	result := []WeightedEdge[T, W]{}
+	for _, from := range g.Nodes() {
+		for _, to := range g.Neighbours(from) {
+			weight, _ := g.Weight(from, to)
+			result = append(result, WeightedEdge[T, W]{Edge: [2]T{from, to}, Weight: weight})
+		}
+	}
+	return result
+}
+
+// edgesOf lists every edge once, like WeightedGraph.Edges.
+func edgesOf[T comparable, W Number](g WeightedTraversable[T, W]) []WeightedEdge[T, W] {
+	if g.IsDirected() {
+		return arcs(g)
+	}
+	result := []WeightedEdge[T, W]{}
+	seen := map[[2]T]struct{}{}
+	for _, e := range arcs(g) {
+		if _, ok := seen[[2]T{e.Edge[1], e.Edge[0]}]; ok {
+			continue
+		}
+		seen[e.Edge] = struct{}{}
+		result = append(result, e)
+	}
+	return result
+}
+
+// weightOf returns the edge weight lookup of g, ignoring the presence flag.
+func weightOf[T comparable, W Number](g WeightedTraversable[T, W]) func(from T, to T) W {
+	return func(from T, to T) W {
+		weight, _ := g.Weight(from, to)
+		return weight
+	}
+}
@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+// ladder is an implicit WeightedTraversable backend: rungs 0..n-1 on two
+// rails, with nothing stored but n. Rail edges weigh 1 and rungs weigh 3.
+type ladder struct{ n int }
+
+type rung struct {
+	rail, step int
+}
+
+func (l ladder) Nodes() []rung {
+	nodes := []rung{}
+	for rail := 0; rail < 2; rail++ {
+		for step := 0; step < l.n; step++ {
+			nodes = append(nodes, rung{rail, step})
+		}
+	}
+	return nodes
+}
+
+func (l ladder) HasNode(node rung) bool {
+	return node.rail >= 0 && node.rail < 2 && node.step >= 0 && node.step < l.n
+}
+
+func (l ladder) HasEdge(from rung, to rung) bool {
+	_, ok := l.Weight(from, to)
+	return ok
+}
+
+func (l ladder) Neighbours(node rung) []rung {
+	if !l.HasNode(node) {
+		return nil
+	}
+	nbrs := []rung{{1 - node.rail, node.step}}
+	for _, step := range []int{node.step - 1, node.step + 1} {
+		if step >= 0 && step < l.n {
+			nbrs = append(nbrs, rung{node.rail, step})
+		}
+	}
+	return nbrs
+}
+
+func (l ladder) IsDirected() bool {
+	return false
+}
+
+func (l ladder) Weight(from rung, to rung) (int, bool) {
+	if !l.HasNode(from) || !l.HasNode(to) {
+		return 0, false
+	}
+	switch {
+	case from.rail == to.rail && (from.step-to.step == 1 || to.step-from.step == 1):
+		return 1, true
+	case from.rail != to.rail && from.step == to.step:
+		return 3, true
+	}
+	return 0, false
+}
+
+// materialize copies any WeightedTraversable into a WeightedGraph.
+func materialize[T comparable](g WeightedTraversable[T, int], repType RepresentationType) *WeightedGraph[T, int] {
+	graphType := Undirected
+	if g.IsDirected() {
+		graphType = Directed
+	}
+	out := NewWeightedGraph[T, int](graphType, repType)
+	for _, node := range g.Nodes() {
+		out.AddNode(node)
+	}
+	for _, e := range edgesOf(g) {
+		out.AddEdge(e.Edge[0], e.Edge[1], e.Weight)
+	}
+	return out
+}
+
+func TestCustomTraversable(t *testing.T) {
+	l := ladder{n: 5}
+	if got := len(edgesOf[rung, int](l)); got != 13 {
+		t.Fatalf("edgesOf found %d edges, want 13", got)
+	}
+	if got := len(arcs[rung, int](l)); got != 26 {
+		t.Errorf("arcs found %d arcs, want 26", got)
+	}
+	if got := edgeNeighboursOf[rung](l)(rung{0, 0}); len(got) != 2 {
+		t.Errorf("edgeNeighboursOf fell back to %v, want Neighbours", got)
+	}
+
+	for _, repType := range representations {
+		g := materialize[rung](l, repType)
+		from, to := rung{0, 0}, rung{1, 4}
+
+		_, cost := DijkstraShortestPath[rung, int](l, from, to)
+		if _, want := g.DijkstraShortestPath(from, to); cost != want || cost != 7 {
+			t.Errorf("%v: Dijkstra cost %d, graph says %d, want 7", repType, cost, want)
+		}
+		if _, total := Kruskal[rung, int](l); total != 11 {
+			t.Errorf("%v: Kruskal weighs %d, want 11", repType, total)
+		}
+		if _, total := g.Prim(); total != 11 {
+			t.Errorf("%v: Prim weighs %d, want 11", repType, total)
+		}
+		if got := len(MaximumMatching[rung](l)); got != 5 {
+			t.Errorf("%v: MaximumMatching has %d pairs, want 5", repType, got)
+		}
+		if got := Bridges[rung](l); len(got) != 0 {
+			t.Errorf("%v: Bridges = %v, want none", repType, got)
+		}
+		if ok, _, _ := IsBipartite[rung](l); !ok {
+			t.Errorf("%v: ladder is bipartite", repType)
+		}
+		if !HasCycle[rung](l) {
+			t.Errorf("%v: ladder has cycles", repType)
+		}
+		result := MaxFlow[rung, int](l, from, to, Dinic)
+		if want := g.MaxFlow(from, to, Dinic); result.Value != want.Value || result.Value != 2 {
+			t.Errorf("%v: MaxFlow = %d, graph says %d, want 2", repType, result.Value, want.Value)
+		}
+		components, _ := ConnectedComponents[rung](l)
+		if len(components) != 1 || !slices.Contains(components[0], to) {
+			t.Errorf("%v: components = %v", repType, components)
+		}
+	}
+}
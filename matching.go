@@ -0,0 +1,249 @@
+package graph
+
+import "errors"
+
+var (
+	ErrNotBipartite      = errors.New("graph: graph is not bipartite")
+	ErrNoPerfectMatching = errors.New("graph: no perfect matching")
+)
+
+func (g *Graph[T]) MaximumBipartiteMatching(left []T, right []T) ([][2]T, error) {
+	return MaximumBipartiteMatching[T](g, left, right)
+}
+
+func (g *WeightedGraph[T, W]) MaximumBipartiteMatching(left []T, right []T) ([][2]T, error) {
+	return MaximumBipartiteMatching[T](g, left, right)
+}
+
+func (g *WeightedGraph[T, W]) MinimumCostAssignment(left []T, right []T) ([][2]T, W, error) {
+	return MinimumCostAssignment[T, W](g, left, right)
+}
+
+// MaximumBipartiteMatching returns a maximum matching between left and right
+// as (left, right) pairs using Hopcroft-Karp. Edges are followed from left to
+// right. If both sides are nil they are found by two-colouring the graph,
+// failing with ErrNotBipartite when that is impossible.
+func MaximumBipartiteMatching[T comparable](g Traversable[T], left []T, right []T) ([][2]T, error) {
+	left, right, err := bipartition(g, left, right)
+	if err != nil {
+		return nil, err
+	}
+	return hopcroftKarp(left, right, g.Neighbours), nil
+}
+
+// MinimumCostAssignment matches every node of the smaller side to a distinct
+// node of the other side, minimising the total weight of the edges used
+// (Hungarian / Kuhn-Munkres). Edges are followed from left to right. Sides
+// are auto-detected as in MaximumBipartiteMatching. If the edges do not allow
+// such an assignment it returns ErrNoPerfectMatching.
+func MinimumCostAssignment[T comparable, W Number](g WeightedTraversable[T, W], left []T, right []T) ([][2]T, W, error) {
+	left, right, err := bipartition[T](g, left, right)
+	if err != nil {
+		return nil, 0, err
+	}
+	transposed := len(left) > len(right)
+	rows, cols := left, right
+	if transposed {
+		rows, cols = right, left
+	}
+	cost := make([][]W, len(rows))
+	allowed := make([][]bool, len(rows))
+	for i, r := range rows {
+		cost[i] = make([]W, len(cols))
+		allowed[i] = make([]bool, len(cols))
+		for j, c := range cols {
+			from, to := r, c
+			if transposed {
+				from, to = c, r
+			}
+			cost[i][j], allowed[i][j] = g.Weight(from, to)
+		}
+	}
+
+	assignment, ok := hungarian(cost, allowed)
+	if !ok {
+		return nil, 0, ErrNoPerfectMatching
+	}
+	pairs := [][2]T{}
+	var total W
+	for i, j := range assignment {
+		total += cost[i][j]
+		if transposed {
+			pairs = append(pairs, [2]T{cols[j], rows[i]})
+		} else {
+			pairs = append(pairs, [2]T{rows[i], cols[j]})
+		}
+	}
+	return pairs, total, nil
+}
+
+func bipartition[T comparable](g Traversable[T], left []T, right []T) ([]T, []T, error) {
+	if left != nil || right != nil {
+		return left, right, nil
+	}
+	ok, colour, _ := IsBipartite(g)
+	if !ok {
+		return nil, nil, ErrNotBipartite
+	}
+	left, right = []T{}, []T{}
+	for _, node := range g.Nodes() {
+		if colour[node] == 0 {
+			left = append(left, node)
+		} else {
+			right = append(right, node)
+		}
+	}
+	return left, right, nil
+}
+
+// hopcroftKarp repeatedly finds a maximal set of shortest vertex-disjoint
+// augmenting paths, layering the left side by BFS and augmenting by DFS.
+func hopcroftKarp[T comparable](left []T, right []T, neighbours func(T) []T) [][2]T {
+	isRight := make(map[T]struct{}, len(right))
+	for _, node := range right {
+		isRight[node] = struct{}{}
+	}
+	adj := make(map[T][]T, len(left))
+	for _, node := range left {
+		for _, nbr := range neighbours(node) {
+			if _, ok := isRight[nbr]; ok {
+				adj[node] = append(adj[node], nbr)
+			}
+		}
+	}
+	matchLeft := map[T]T{}
+	matchRight := map[T]T{}
+	dist := map[T]int{}
+
+	bfs := func() bool {
+		queue := []T{}
+		for _, node := range left {
+			if _, matched := matchLeft[node]; !matched {
+				dist[node] = 0
+				queue = append(queue, node)
+			} else {
+				dist[node] = -1
+			}
+		}
+		found := false
+		for len(queue) > 0 {
+			curr := queue[0]
+			queue = queue[1:]
+			for _, r := range adj[curr] {
+				next, matched := matchRight[r]
+				if !matched {
+					found = true
+				} else if dist[next] == -1 {
+					dist[next] = dist[curr] + 1
+					queue = append(queue, next)
+				}
+			}
+		}
+		return found
+	}
+
+	var dfs func(node T) bool
+	dfs = func(node T) bool {
+		for _, r := range adj[node] {
+			next, matched := matchRight[r]
+			if !matched || (dist[next] == dist[node]+1 && dfs(next)) {
+				matchLeft[node] = r
+				matchRight[r] = node
+				return true
+			}
+		}
+		dist[node] = -1
+		return false
+	}
+
+	for bfs() {
+		for _, node := range left {
+			if _, matched := matchLeft[node]; !matched {
+				dfs(node)
+			}
+		}
+	}
+
+	pairs := make([][2]T, 0, len(matchLeft))
+	for _, node := range left {
+		if r, matched := matchLeft[node]; matched {
+			pairs = append(pairs, [2]T{node, r})
+		}
+	}
+	return pairs
+}
+
+// hungarian solves the rectangular assignment problem for a cost matrix with
+// no more rows than columns, using only the cells marked in allowed. It
+// returns the column assigned to every row, or false if some row cannot be
+// assigned.
+func hungarian[W Number](cost [][]W, allowed [][]bool) ([]int, bool) {
+	n := len(cost)
+	if n == 0 {
+		return []int{}, true
+	}
+	m := len(cost[0])
+	inf := Infinity[W]()
+	// 1-indexed potentials; column 0 is a virtual column used as the root
+	u := make([]W, n+1)
+	v := make([]W, m+1)
+	p := make([]int, m+1) // row matched to each column
+	way := make([]int, m+1)
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]W, m+1)
+		used := make([]bool, m+1)
+		for j := range minv {
+			minv[j] = inf
+		}
+		for {
+			used[j0] = true
+			i0 := p[j0]
+			delta := inf
+			j1 := 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				if allowed[i0-1][j-1] {
+					if cur := cost[i0-1][j-1] - u[i0] - v[j]; minv[j] == inf || cur < minv[j] {
+						minv[j] = cur
+						way[j] = j0
+					}
+				}
+				if minv[j] != inf && (j1 == 0 || minv[j] < delta) {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			if j1 == 0 {
+				return nil, false
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else if minv[j] != inf {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+	assignment := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if p[j] != 0 {
+			assignment[p[j]-1] = j - 1
+		}
+	}
+	return assignment, true
+}
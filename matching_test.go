@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// checkMatching fails t unless pairs is a matching of g with size pairs, and
+// with every pair's first node in left when left is given.
+func checkMatching[T comparable](t *testing.T, label string, g Traversable[T], pairs [][2]T, left []T, size int) {
+	t.Helper()
+	if len(pairs) != size {
+		t.Errorf("%s: matching %v has %d pairs, want %d", label, pairs, len(pairs), size)
+	}
+	used := map[T]bool{}
+	for _, p := range pairs {
+		if !slices.Contains(g.Neighbours(p[0]), p[1]) {
+			t.Errorf("%s: pair %v is not an edge", label, p)
+		}
+		if left != nil && !slices.Contains(left, p[0]) {
+			t.Errorf("%s: pair %v does not start on the left", label, p)
+		}
+		if used[p[0]] || used[p[1]] {
+			t.Errorf("%s: pair %v reuses a node", label, p)
+		}
+		used[p[0]], used[p[1]] = true, true
+	}
+}
+
+func TestMaximumBipartiteMatching(t *testing.T) {
+	left, right := []string{"1", "2", "3", "4"}, []string{"a", "b", "c", "d"}
+	tests := []struct {
+		name  string
+		edges [][2]string
+		size  int
+	}{
+		{"hall violation", [][2]string{{"1", "a"}, {"1", "b"}, {"2", "a"}, {"3", "b"}, {"3", "c"}, {"4", "c"}}, 3},
+		{"perfect", [][2]string{{"1", "a"}, {"1", "b"}, {"2", "a"}, {"3", "b"}, {"3", "c"}, {"3", "d"}, {"4", "c"}}, 4},
+		{"no edges", nil, 0},
+	}
+	for _, tt := range tests {
+		for _, graphType := range []GraphType{Directed, Undirected} {
+			for _, repType := range representations {
+				g := buildGraph(graphType, repType, tt.edges)
+				pairs, err := g.MaximumBipartiteMatching(left, right)
+				if err != nil {
+					t.Fatalf("%s/%v/%v: %v", tt.name, graphType, repType, err)
+				}
+				checkMatching[string](t, tt.name, g, pairs, left, tt.size)
+			}
+		}
+	}
+}
+
+func TestMaximumBipartiteMatchingAutoSides(t *testing.T) {
+	for _, repType := range representations {
+		g := buildGraph(Undirected, repType, [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "e"}, {"e", "f"}})
+		pairs, err := g.MaximumBipartiteMatching(nil, nil)
+		if err != nil {
+			t.Fatalf("%v: %v", repType, err)
+		}
+		checkMatching[string](t, "path", g, pairs, nil, 3)
+
+		g.AddEdge("f", "a")
+		g.AddEdge("a", "c")
+		if _, err := g.MaximumBipartiteMatching(nil, nil); !errors.Is(err, ErrNotBipartite) {
+			t.Errorf("%v: err = %v, want ErrNotBipartite", repType, err)
+		}
+	}
+}
+
+func TestMinimumCostAssignment(t *testing.T) {
+	square := []weightedEdgeCase{
+		{"a", "x", 4}, {"a", "y", 1}, {"a", "z", 3},
+		{"b", "x", 2}, {"b", "y", 0}, {"b", "z", 5},
+		{"c", "x", 3}, {"c", "y", 2}, {"c", "z", 2},
+	}
+	wide := []weightedEdgeCase{{"a", "x", 5}, {"a", "y", 1}, {"a", "z", 9}, {"b", "x", 1}, {"b", "y", 2}, {"b", "z", 8}}
+	tests := []struct {
+		name        string
+		graphType   GraphType
+		edges       []weightedEdgeCase
+		left, right []string
+		pairs       [][2]string
+		total       int
+	}{
+		{"square", Directed, square, []string{"a", "b", "c"}, []string{"x", "y", "z"}, [][2]string{{"a", "y"}, {"b", "x"}, {"c", "z"}}, 5},
+		{"more columns", Directed, wide, []string{"a", "b"}, []string{"x", "y", "z"}, [][2]string{{"a", "y"}, {"b", "x"}}, 2},
+		{"more rows", Undirected, wide, []string{"x", "y", "z"}, []string{"a", "b"}, [][2]string{{"x", "b"}, {"y", "a"}}, 2},
+		{"missing edges", Directed, []weightedEdgeCase{{"a", "x", 9}, {"a", "y", 1}, {"b", "y", 1}}, []string{"a", "b"}, []string{"x", "y"}, [][2]string{{"a", "x"}, {"b", "y"}}, 10},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := buildWeighted(tt.graphType, repType, tt.edges)
+			pairs, total, err := g.MinimumCostAssignment(tt.left, tt.right)
+			if err != nil {
+				t.Fatalf("%s/%v: %v", tt.name, repType, err)
+			}
+			slices.SortFunc(pairs, func(p, q [2]string) int {
+				return strings.Compare(p[0], q[0])
+			})
+			if !slices.Equal(pairs, tt.pairs) || total != tt.total {
+				t.Errorf("%s/%v: got %v, %d, want %v, %d", tt.name, repType, pairs, total, tt.pairs, tt.total)
+			}
+		}
+	}
+}
+
+func TestMinimumCostAssignmentImpossible(t *testing.T) {
+	g := buildWeighted(Directed, AdjacencyList, []weightedEdgeCase{{"a", "x", 1}, {"b", "x", 2}, {"c", "y", 1}})
+	if _, _, err := g.MinimumCostAssignment([]string{"a", "b"}, []string{"x", "y"}); !errors.Is(err, ErrNoPerfectMatching) {
+		t.Errorf("err = %v, want ErrNoPerfectMatching", err)
+	}
+	g.AddEdge("x", "c", 1)
+	g.AddEdge("c", "a", 1)
+	if _, _, err := g.MinimumCostAssignment(nil, nil); !errors.Is(err, ErrNotBipartite) {
+		t.Errorf("auto sides: err = %v, want ErrNotBipartite", err)
+	}
+}
@@ -0,0 +1,332 @@
+package graph
+
+// MaxFlowAlgorithm selects the algorithm used by MaxFlow.
+type MaxFlowAlgorithm int
+
+const (
+	EdmondsKarp MaxFlowAlgorithm = iota
+	Dinic
+	PushRelabel
+)
+
+// MaxFlowResult is the outcome of MaxFlow. Flow holds the flow routed
+// through every edge of the graph, keyed by (from, to); for Undirected
+// graphs the key is oriented in the direction the flow actually travels.
+// Residual is a Directed graph of the remaining capacities.
+type MaxFlowResult[T comparable, W Number] struct {
+	Value    W
+	Flow     map[[2]T]W
+	Residual *WeightedGraph[T, W]
+}
+
+func (g *WeightedGraph[T, W]) MaxFlow(source T, sink T, algorithm MaxFlowAlgorithm) *MaxFlowResult[T, W] {
+	return maxFlow[T, W](g, source, sink, algorithm, g.repType)
+}
+
+func (g *WeightedGraph[T, W]) MinCut(source T, sink T) ([]T, []WeightedEdge[T, W]) {
+	return MinCut[T, W](g, source, sink)
+}
+
+// MaxFlow computes a maximum flow from source to sink, treating edge weights
+// as non-negative capacities. The residual graph uses the AdjacencyList
+// representation; the method form keeps the graph's representation.
+func MaxFlow[T comparable, W Number](g WeightedTraversable[T, W], source T, sink T, algorithm MaxFlowAlgorithm) *MaxFlowResult[T, W] {
+	return maxFlow(g, source, sink, algorithm, AdjacencyList)
+}
+
+func maxFlow[T comparable, W Number](g WeightedTraversable[T, W], source T, sink T, algorithm MaxFlowAlgorithm, repType RepresentationType) *MaxFlowResult[T, W] {
+	net, edges := flowNetwork(g)
+	s, sOk := net.index[source]
+	t, tOk := net.index[sink]
+	var value W
+	if sOk && tOk && s != t {
+		switch algorithm {
+		case EdmondsKarp:
+			value = net.edmondsKarp(s, t)
+		case Dinic:
+			value = net.dinic(s, t)
+		case PushRelabel:
+			value = net.pushRelabel(s, t)
+		}
+	}
+
+	flow := make(map[[2]T]W, len(edges))
+	for i, e := range edges {
+		f := net.origCap[net.edgeArc[i]] - net.cap[net.edgeArc[i]]
+		if f < 0 {
+			flow[[2]T{e.Edge[1], e.Edge[0]}] = -f
+		} else {
+			flow[e.Edge] = f
+		}
+	}
+	return &MaxFlowResult[T, W]{Value: value, Flow: flow, Residual: net.residual(repType)}
+}
+
+// MinCut returns the source side of a minimum source-sink cut and the edges
+// crossing it. The capacities of the cut edges add up to the maximum flow.
+func MinCut[T comparable, W Number](g WeightedTraversable[T, W], source T, sink T) ([]T, []WeightedEdge[T, W]) {
+	net, edges := flowNetwork(g)
+	s, sOk := net.index[source]
+	t, tOk := net.index[sink]
+	if !sOk || !tOk {
+		return []T{}, []WeightedEdge[T, W]{}
+	}
+	if s != t {
+		net.dinic(s, t)
+	}
+
+	reachable := net.reachable(s)
+	sourceSide := []T{}
+	for i, node := range net.nodes {
+		if reachable[i] {
+			sourceSide = append(sourceSide, node)
+		}
+	}
+	cut := []WeightedEdge[T, W]{}
+	for _, e := range edges {
+		from, to := reachable[net.index[e.Edge[0]]], reachable[net.index[e.Edge[1]]]
+		if from && !to {
+			cut = append(cut, e)
+		} else if to && !from && !g.IsDirected() {
+			cut = append(cut, WeightedEdge[T, W]{Edge: [2]T{e.Edge[1], e.Edge[0]}, Weight: e.Weight})
+		}
+	}
+	return sourceSide, cut
+}
+
+// network is an index based residual network. Arcs are stored in pairs so
+// that arc i^1 is the reverse of arc i.
+type network[T comparable, W Number] struct {
+	nodes   []T
+	index   map[T]int
+	adj     [][]int
+	to      []int
+	cap     []W
+	origCap []W
+	cost    []W   // per-unit arc costs, only used by min-cost flow
+	edgeArc []int // arc carrying each input edge
+}
+
+func flowNetwork[T comparable, W Number](g WeightedTraversable[T, W]) (*network[T, W], []WeightedEdge[T, W]) {
+	nodes := g.Nodes()
+	net := &network[T, W]{
+		nodes: nodes,
+		index: make(map[T]int, len(nodes)),
+		adj:   make([][]int, len(nodes)),
+	}
+	for i, node := range nodes {
+		net.index[node] = i
+	}
+	edges := []WeightedEdge[T, W]{}
+	for _, e := range edgesOf(g) {
+		if e.Edge[0] == e.Edge[1] {
+			continue
+		}
+		capacity := max(e.Weight, 0)
+		var reverse W
+		if !g.IsDirected() {
+			reverse = capacity
+		}
+		net.edgeArc = append(net.edgeArc, net.addArc(net.index[e.Edge[0]], net.index[e.Edge[1]], capacity, reverse))
+		edges = append(edges, e)
+	}
+	return net, edges
+}
+
+func (net *network[T, W]) addArc(from int, to int, capacity W, reverse W) int {
+	arc := len(net.to)
+	net.to = append(net.to, to, from)
+	net.cap = append(net.cap, capacity, reverse)
+	net.origCap = append(net.origCap, capacity, reverse)
+	net.adj[from] = append(net.adj[from], arc)
+	net.adj[to] = append(net.adj[to], arc+1)
+	return arc
+}
+
+func (net *network[T, W]) edmondsKarp(s int, t int) W {
+	var total W
+	for {
+		parentArc := make([]int, len(net.nodes))
+		for i := range parentArc {
+			parentArc[i] = -1
+		}
+		parentArc[s] = -2
+		queue := []int{s}
+		for len(queue) > 0 && parentArc[t] == -1 {
+			curr := queue[0]
+			queue = queue[1:]
+			for _, arc := range net.adj[curr] {
+				if next := net.to[arc]; net.cap[arc] > 0 && parentArc[next] == -1 {
+					parentArc[next] = arc
+					queue = append(queue, next)
+				}
+			}
+		}
+		if parentArc[t] == -1 {
+			return total
+		}
+		bottleneck := Infinity[W]()
+		for v := t; v != s; v = net.to[parentArc[v]^1] {
+			bottleneck = min(bottleneck, net.cap[parentArc[v]])
+		}
+		for v := t; v != s; v = net.to[parentArc[v]^1] {
+			net.cap[parentArc[v]] -= bottleneck
+			net.cap[parentArc[v]^1] += bottleneck
+		}
+		total += bottleneck
+	}
+}
+
+func (net *network[T, W]) dinic(s int, t int) W {
+	n := len(net.nodes)
+	level := make([]int, n)
+	iter := make([]int, n)
+
+	var push func(v int, limit W) W
+	push = func(v int, limit W) W {
+		if v == t {
+			return limit
+		}
+		for ; iter[v] < len(net.adj[v]); iter[v]++ {
+			arc := net.adj[v][iter[v]]
+			next := net.to[arc]
+			if net.cap[arc] <= 0 || level[next] != level[v]+1 {
+				continue
+			}
+			if pushed := push(next, min(limit, net.cap[arc])); pushed > 0 {
+				net.cap[arc] -= pushed
+				net.cap[arc^1] += pushed
+				return pushed
+			}
+		}
+		return 0
+	}
+
+	var total W
+	for {
+		for i := range level {
+			level[i] = -1
+		}
+		level[s] = 0
+		queue := []int{s}
+		for len(queue) > 0 {
+			curr := queue[0]
+			queue = queue[1:]
+			for _, arc := range net.adj[curr] {
+				if next := net.to[arc]; net.cap[arc] > 0 && level[next] == -1 {
+					level[next] = level[curr] + 1
+					queue = append(queue, next)
+				}
+			}
+		}
+		if level[t] == -1 {
+			return total
+		}
+		for i := range iter {
+			iter[i] = 0
+		}
+		for {
+			pushed := push(s, Infinity[W]())
+			if pushed == 0 {
+				break
+			}
+			total += pushed
+		}
+	}
+}
+
+// pushRelabel is the FIFO variant of the generic push-relabel algorithm.
+func (net *network[T, W]) pushRelabel(s int, t int) W {
+	n := len(net.nodes)
+	height := make([]int, n)
+	excess := make([]W, n)
+	iter := make([]int, n)
+	inQueue := make([]bool, n)
+	active := []int{}
+	activate := func(v int) {
+		if v != s && v != t && !inQueue[v] && excess[v] > 0 {
+			inQueue[v] = true
+			active = append(active, v)
+		}
+	}
+
+	height[s] = n
+	for _, arc := range net.adj[s] {
+		next := net.to[arc]
+		excess[next] += net.cap[arc]
+		excess[s] -= net.cap[arc]
+		net.cap[arc^1] += net.cap[arc]
+		net.cap[arc] = 0
+		activate(next)
+	}
+
+	for len(active) > 0 {
+		v := active[0]
+		active = active[1:]
+		inQueue[v] = false
+		for excess[v] > 0 {
+			if iter[v] == len(net.adj[v]) {
+				lowest := 2 * n
+				for _, arc := range net.adj[v] {
+					if net.cap[arc] > 0 {
+						lowest = min(lowest, height[net.to[arc]])
+					}
+				}
+				height[v] = lowest + 1
+				iter[v] = 0
+				continue
+			}
+			arc := net.adj[v][iter[v]]
+			next := net.to[arc]
+			if net.cap[arc] > 0 && height[v] == height[next]+1 {
+				delta := min(excess[v], net.cap[arc])
+				net.cap[arc] -= delta
+				net.cap[arc^1] += delta
+				excess[v] -= delta
+				excess[next] += delta
+				activate(next)
+			} else {
+				iter[v]++
+			}
+		}
+	}
+	return excess[t]
+}
+
+func (net *network[T, W]) reachable(s int) []bool {
+	seen := make([]bool, len(net.nodes))
+	seen[s] = true
+	queue := []int{s}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		for _, arc := range net.adj[curr] {
+			if next := net.to[arc]; net.cap[arc] > 0 && !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return seen
+}
+
+// residual returns the remaining capacities as a Directed graph, merging
+// parallel arcs between the same pair of nodes.
+func (net *network[T, W]) residual(repType RepresentationType) *WeightedGraph[T, W] {
+	residual := NewWeightedGraph[T, W](Directed, repType)
+	for _, node := range net.nodes {
+		residual.AddNode(node)
+	}
+	for arc, capacity := range net.cap {
+		if capacity <= 0 {
+			continue
+		}
+		from, to := net.nodes[net.to[arc^1]], net.nodes[net.to[arc]]
+		existing, ok := residual.Weight(from, to)
+		if !ok {
+			existing = 0
+		}
+		residual.AddEdge(from, to, existing+capacity)
+	}
+	return residual
+}
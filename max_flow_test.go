@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+// clrsNetwork is the flow network from CLRS figure 26.1, with maximum flow 23.
+var clrsNetwork = []weightedEdgeCase{
+	{"s", "v1", 16}, {"s", "v2", 13}, {"v2", "v1", 4}, {"v1", "v3", 12}, {"v3", "v2", 9},
+	{"v2", "v4", 14}, {"v4", "v3", 7}, {"v3", "t", 20}, {"v4", "t", 4},
+}
+
+var maxFlowAlgorithms = map[string]MaxFlowAlgorithm{
+	"EdmondsKarp": EdmondsKarp,
+	"Dinic":       Dinic,
+	"PushRelabel": PushRelabel,
+}
+
+// checkFlow fails t unless flow respects the capacities of g and is
+// conserved at every node except source and sink.
+func checkFlow(t *testing.T, label string, g *WeightedGraph[string, int], flow map[[2]string]int, source, sink string, value int) {
+	t.Helper()
+	net := map[string]int{}
+	for edge, f := range flow {
+		if f < 0 {
+			t.Errorf("%s: negative flow %d on %v", label, f, edge)
+		}
+		if f == 0 {
+			continue
+		}
+		capacity, ok := g.Weight(edge[0], edge[1])
+		if !ok || f > capacity {
+			t.Errorf("%s: flow %d on %v exceeds capacity %d, %v", label, f, edge, capacity, ok)
+		}
+		net[edge[0]] -= f
+		net[edge[1]] += f
+	}
+	for node, excess := range net {
+		switch node {
+		case source:
+			excess = -excess - value
+		case sink:
+			excess -= value
+		}
+		if excess != 0 {
+			t.Errorf("%s: flow is not conserved at %s (%d)", label, node, excess)
+		}
+	}
+}
+
+func TestMaxFlow(t *testing.T) {
+	tests := []struct {
+		name         string
+		graphType    GraphType
+		edges        []weightedEdgeCase
+		source, sink string
+		value        int
+	}{
+		{"clrs", Directed, clrsNetwork, "s", "t", 23},
+		{"reverse direction", Directed, clrsNetwork, "t", "s", 0},
+		{"undirected diamond", Undirected, []weightedEdgeCase{{"s", "a", 3}, {"s", "b", 2}, {"a", "b", 5}, {"a", "t", 2}, {"b", "t", 4}}, "s", "t", 5},
+		{"source is sink", Directed, clrsNetwork, "s", "s", 0},
+		{"missing sink", Directed, clrsNetwork, "s", "q", 0},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := buildWeighted(tt.graphType, repType, tt.edges)
+			for name, algorithm := range maxFlowAlgorithms {
+				label := tt.name + "/" + name
+				result := g.MaxFlow(tt.source, tt.sink, algorithm)
+				if result.Value != tt.value {
+					t.Errorf("%s/%v: Value = %d, want %d", label, repType, result.Value, tt.value)
+				}
+				checkFlow(t, label, g, result.Flow, tt.source, tt.sink, result.Value)
+				if !result.Residual.IsDirected() {
+					t.Errorf("%s/%v: residual graph is undirected", label, repType)
+				}
+				if result.Value > 0 {
+					if path := result.Residual.BFSShortestPath(tt.source, tt.sink); len(path) != 0 {
+						t.Errorf("%s/%v: residual still has augmenting path %v", label, repType, path)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestMinCut(t *testing.T) {
+	for _, repType := range representations {
+		g := buildWeighted(Directed, repType, clrsNetwork)
+		side, cut := g.MinCut("s", "t")
+		total := 0
+		for _, e := range cut {
+			if !slices.Contains(side, e.Edge[0]) || slices.Contains(side, e.Edge[1]) {
+				t.Errorf("%v: cut edge %v does not cross the cut", repType, e.Edge)
+			}
+			total += e.Weight
+		}
+		if total != 23 {
+			t.Errorf("%v: cut %v weighs %d, want 23", repType, cut, total)
+		}
+		if !slices.Contains(side, "s") || slices.Contains(side, "t") {
+			t.Errorf("%v: source side %v", repType, side)
+		}
+	}
+
+	g := buildWeighted(Undirected, AdjacencyList, []weightedEdgeCase{{"a", "s", 1}, {"a", "t", 5}})
+	if side, cut := g.MinCut("s", "t"); !slices.Equal(side, []string{"s"}) || len(cut) != 1 || cut[0].Edge != [2]string{"s", "a"} {
+		t.Errorf("undirected: got %v, %v, want the edge oriented s -> a", side, cut)
+	}
+}
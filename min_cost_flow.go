@@ -0,0 +1,219 @@
+package graph
+
+import "container/heap"
+
+// FlowNetwork is a directed graph whose edges carry a capacity and a
+// per-unit cost. Parallel edges between the same pair of nodes are kept.
+type FlowNetwork[T comparable, W Number] struct {
+	nodes map[T]struct{}
+	order []T
+	edges []FlowEdge[T, W]
+}
+
+func NewFlowNetwork[T comparable, W Number]() *FlowNetwork[T, W] {
+	return &FlowNetwork[T, W]{
+		nodes: make(map[T]struct{}),
+		order: []T{},
+		edges: []FlowEdge[T, W]{},
+	}
+}
+
+// NewTransportationNetwork builds the classic transportation problem: source
+// feeds suppliers[i] up to supply[i], consumers[j] drains into sink up to
+// demand[j], and cost[i][j] is the per-unit price of shipping from
+// suppliers[i] to consumers[j]; a cost of Infinity[W]() leaves that route
+// out. Solve it with MinCostMaxFlow(source, sink).
+//
+// The edges are added in a fixed order: source to each supplier, then each
+// consumer to sink, then the routes row by row, so the i-th entry of a
+// result's Flow always belongs to the same edge.
+func NewTransportationNetwork[T comparable, W Number](suppliers []T, supply []W, consumers []T, demand []W, cost [][]W, source T, sink T) *FlowNetwork[T, W] {
+	fn := NewFlowNetwork[T, W]()
+	fn.AddNode(source)
+	fn.AddNode(sink)
+	for i, supplier := range suppliers {
+		fn.AddEdge(source, supplier, supply[i], 0)
+	}
+	for j, consumer := range consumers {
+		fn.AddEdge(consumer, sink, demand[j], 0)
+	}
+	for i, supplier := range suppliers {
+		for j, consumer := range consumers {
+			if cost[i][j] != Infinity[W]() {
+				fn.AddEdge(supplier, consumer, Infinity[W](), cost[i][j])
+			}
+		}
+	}
+	return fn
+}
+
+func (fn *FlowNetwork[T, W]) AddNode(node T) {
+	if _, exists := fn.nodes[node]; exists {
+		return
+	}
+	fn.nodes[node] = struct{}{}
+	fn.order = append(fn.order, node)
+}
+
+func (fn *FlowNetwork[T, W]) AddEdge(from T, to T, capacity W, cost W) {
+	fn.AddNode(from)
+	fn.AddNode(to)
+	fn.edges = append(fn.edges, FlowEdge[T, W]{Edge: [2]T{from, to}, Capacity: capacity, Cost: cost})
+}
+
+func (fn *FlowNetwork[T, W]) HasNode(node T) bool {
+	_, exists := fn.nodes[node]
+	return exists
+}
+
+func (fn *FlowNetwork[T, W]) Nodes() []T {
+	return append([]T{}, fn.order...)
+}
+
+// Edges returns the edges in the order they were added.
+func (fn *FlowNetwork[T, W]) Edges() []FlowEdge[T, W] {
+	return append([]FlowEdge[T, W]{}, fn.edges...)
+}
+
+// MinCostFlowResult is the outcome of MinCostFlow. Flow[i] is the flow on
+// the i-th edge returned by Edges.
+type MinCostFlowResult[W Number] struct {
+	Value W
+	Cost  W
+	Flow  []W
+}
+
+// MinCostMaxFlow sends as much flow as possible from source to sink at the
+// lowest total cost.
+func (fn *FlowNetwork[T, W]) MinCostMaxFlow(source T, sink T) (*MinCostFlowResult[W], error) {
+	return fn.MinCostFlow(source, sink, Infinity[W]())
+}
+
+// MinCostFlow sends up to limit units from source to sink at the lowest
+// total cost using successive shortest paths with Johnson potentials.
+// Negative costs are allowed; a negative cost cycle reachable from source is
+// reported as a *NegativeCycleError.
+func (fn *FlowNetwork[T, W]) MinCostFlow(source T, sink T, limit W) (*MinCostFlowResult[W], error) {
+	net := &network[T, W]{
+		nodes: fn.order,
+		index: make(map[T]int, len(fn.order)),
+		adj:   make([][]int, len(fn.order)),
+	}
+	for i, node := range fn.order {
+		net.index[node] = i
+	}
+	for _, e := range fn.edges {
+		arc := net.addArc(net.index[e.Edge[0]], net.index[e.Edge[1]], max(e.Capacity, 0), 0)
+		net.cost = append(net.cost, e.Cost, -e.Cost)
+		net.edgeArc = append(net.edgeArc, arc)
+	}
+
+	result := &MinCostFlowResult[W]{Flow: make([]W, len(fn.edges))}
+	s, sOk := net.index[source]
+	t, tOk := net.index[sink]
+	if !sOk || !tOk || s == t {
+		return result, nil
+	}
+
+	potential, err := net.costPotentials(s)
+	if err != nil {
+		return nil, err
+	}
+	n := len(net.adj)
+	inf := Infinity[W]()
+	for result.Value < limit {
+		dist := make([]W, n)
+		parentArc := make([]int, n)
+		for i := range dist {
+			dist[i] = inf
+			parentArc[i] = -1
+		}
+		dist[s] = 0
+		pq := &priorityQueue[int, W]{{node: s, priority: 0}}
+		for pq.Len() > 0 {
+			curr := heap.Pop(pq).(pqItem[int, W])
+			if curr.priority != dist[curr.node] {
+				continue
+			}
+			for _, arc := range net.adj[curr.node] {
+				next := net.to[arc]
+				if net.cap[arc] <= 0 {
+					continue
+				}
+				alt := curr.priority + net.cost[arc] + potential[curr.node] - potential[next]
+				if alt < dist[next] {
+					dist[next] = alt
+					parentArc[next] = arc
+					heap.Push(pq, pqItem[int, W]{node: next, priority: alt})
+				}
+			}
+		}
+		if dist[t] == inf {
+			break
+		}
+		for i := range potential {
+			if dist[i] != inf {
+				potential[i] += dist[i]
+			}
+		}
+
+		amount := limit - result.Value
+		for v := t; v != s; v = net.to[parentArc[v]^1] {
+			amount = min(amount, net.cap[parentArc[v]])
+		}
+		for v := t; v != s; v = net.to[parentArc[v]^1] {
+			net.cap[parentArc[v]] -= amount
+			net.cap[parentArc[v]^1] += amount
+			result.Cost += amount * net.cost[parentArc[v]]
+		}
+		result.Value += amount
+	}
+
+	for i, arc := range net.edgeArc {
+		result.Flow[i] = net.origCap[arc] - net.cap[arc]
+	}
+	return result, nil
+}
+
+// costPotentials runs Bellman-Ford over the arcs with spare capacity so that
+// every reduced cost seen by Dijkstra is non-negative.
+func (net *network[T, W]) costPotentials(s int) ([]W, error) {
+	n := len(net.adj)
+	inf := Infinity[W]()
+	dist := make([]W, n)
+	for i := range dist {
+		dist[i] = inf
+	}
+	dist[s] = 0
+	prev := map[int]int{}
+	for round := 0; round < n; round++ {
+		changed := -1
+		for arc, capacity := range net.cap {
+			from, to := net.to[arc^1], net.to[arc]
+			if capacity <= 0 || dist[from] == inf {
+				continue
+			}
+			if alt := dist[from] + net.cost[arc]; alt < dist[to] {
+				dist[to] = alt
+				prev[to] = from
+				changed = to
+			}
+		}
+		if changed == -1 {
+			for i := range dist {
+				if dist[i] == inf {
+					dist[i] = 0
+				}
+			}
+			return dist, nil
+		}
+		if round == n-1 {
+			cycle := []T{}
+			for _, i := range negativeCycle(prev, changed, n) {
+				cycle = append(cycle, net.nodes[i])
+			}
+			return nil, &NegativeCycleError[T]{Cycle: cycle}
+		}
+	}
+	return dist, nil
+}
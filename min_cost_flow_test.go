@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+type flowEdgeCase struct {
+	from, to       string
+	capacity, cost int
+}
+
+func buildFlowNetwork(edges []flowEdgeCase) *FlowNetwork[string, int] {
+	fn := NewFlowNetwork[string, int]()
+	for _, e := range edges {
+		fn.AddEdge(e.from, e.to, e.capacity, e.cost)
+	}
+	return fn
+}
+
+func TestMinCostFlow(t *testing.T) {
+	saturated := []flowEdgeCase{{"s", "a", 2, 1}, {"s", "b", 1, 2}, {"a", "b", 1, 1}, {"a", "t", 1, 3}, {"b", "t", 2, 1}}
+	tests := []struct {
+		name         string
+		edges        []flowEdgeCase
+		source, sink string
+		limit        int
+		value, cost  int
+		flow         []int
+	}{
+		{"max flow", saturated, "s", "t", Infinity[int](), 3, 10, []int{2, 1, 1, 1, 2}},
+		{"one unit", saturated, "s", "t", 1, 1, 3, nil},
+		{"two units", saturated, "s", "t", 2, 2, 6, nil},
+		{"negative cost", []flowEdgeCase{{"s", "a", 1, -5}, {"a", "t", 1, 1}, {"s", "t", 1, 0}}, "s", "t", Infinity[int](), 2, -4, []int{1, 1, 1}},
+		{"parallel edges", []flowEdgeCase{{"s", "t", 1, 5}, {"s", "t", 2, 1}}, "s", "t", 2, 2, 2, []int{0, 2}},
+		{"missing sink", saturated, "s", "q", Infinity[int](), 0, 0, []int{0, 0, 0, 0, 0}},
+		{"source is sink", saturated, "s", "s", Infinity[int](), 0, 0, []int{0, 0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		fn := buildFlowNetwork(tt.edges)
+		result, err := fn.MinCostFlow(tt.source, tt.sink, tt.limit)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if result.Value != tt.value || result.Cost != tt.cost {
+			t.Errorf("%s: got value %d, cost %d, want %d, %d", tt.name, result.Value, result.Cost, tt.value, tt.cost)
+		}
+		if tt.flow != nil && !slices.Equal(result.Flow, tt.flow) {
+			t.Errorf("%s: Flow = %v, want %v", tt.name, result.Flow, tt.flow)
+		}
+		cost := 0
+		for i, e := range fn.Edges() {
+			if result.Flow[i] < 0 || result.Flow[i] > e.Capacity {
+				t.Errorf("%s: flow %d on %v outside [0, %d]", tt.name, result.Flow[i], e.Edge, e.Capacity)
+			}
+			cost += result.Flow[i] * e.Cost
+		}
+		if cost != result.Cost {
+			t.Errorf("%s: edge flows cost %d, result says %d", tt.name, cost, result.Cost)
+		}
+	}
+}
+
+func TestMinCostFlowNegativeCycle(t *testing.T) {
+	fn := buildFlowNetwork([]flowEdgeCase{{"s", "a", 1, 0}, {"a", "b", 1, -2}, {"b", "a", 1, 1}, {"b", "t", 1, 0}})
+	_, err := fn.MinCostMaxFlow("s", "t")
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) || !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("err = %v, want a NegativeCycleError", err)
+	}
+	if len(cycleErr.Cycle) != 2 || !slices.Contains(cycleErr.Cycle, "a") || !slices.Contains(cycleErr.Cycle, "b") {
+		t.Errorf("Cycle = %v, want a and b", cycleErr.Cycle)
+	}
+}
+
+func TestTransportationNetwork(t *testing.T) {
+	suppliers, supply := []string{"p", "q", "r"}, []int{20, 30, 10}
+	consumers, demand := []string{"x", "y"}, []int{25, 25}
+	none := Infinity[int]()
+	cost := [][]int{{2, 4}, {3, 1}, {none, 9}}
+	fn := NewTransportationNetwork(suppliers, supply, consumers, demand, cost, "source", "sink")
+	want := [][2]string{
+		{"source", "p"}, {"source", "q"}, {"source", "r"}, {"x", "sink"}, {"y", "sink"},
+		{"p", "x"}, {"p", "y"}, {"q", "x"}, {"q", "y"}, {"r", "y"},
+	}
+	edges := fn.Edges()
+	if len(edges) != len(want) {
+		t.Fatalf("got %d edges, want %d", len(edges), len(want))
+	}
+	for i, e := range edges {
+		if e.Edge != want[i] {
+			t.Errorf("edge %d is %v, want %v", i, e.Edge, want[i])
+		}
+	}
+
+	result, err := fn.MinCostMaxFlow("source", "sink")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Value != 50 || result.Cost != 80 {
+		t.Errorf("got value %d, cost %d, want 50, 80", result.Value, result.Cost)
+	}
+	shipped := map[[2]string]int{}
+	for i, e := range edges {
+		shipped[e.Edge] = result.Flow[i]
+	}
+	routes := map[[2]string]int{{"p", "x"}: 20, {"p", "y"}: 0, {"q", "x"}: 5, {"q", "y"}: 25, {"r", "y"}: 0}
+	for route, amount := range routes {
+		if shipped[route] != amount {
+			t.Errorf("%v ships %d, want %d", route, shipped[route], amount)
+		}
+	}
+}
@@ -0,0 +1,392 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// EdgeID identifies an edge of a Multigraph. IDs are never reused, so an ID
+// stays valid until its edge is removed.
+type EdgeID int
+
+// MultiEdge is an edge of a Multigraph.
+type MultiEdge[T comparable, W Number] struct {
+	ID     EdgeID
+	Edge   [2]T // from, to
+	Weight W
+}
+
+// Multigraph is a weighted graph that keeps parallel edges, each with its own
+// EdgeID and weight. Self-loops are allowed. As a WeightedTraversable it
+// reports the lightest of the parallel edges between two nodes, so path and
+// spanning tree algorithms give the same answer as on the multigraph itself;
+// use FlowNetwork for flows, where parallel capacities add up. Neighbours
+// lists each neighbour once, but DepthFirstSearch, HasCycle, Bridges,
+// ArticulationPoints, BiconnectedComponents and TwoEdgeConnectedComponents
+// follow every parallel edge, so two edges between the same nodes form a
+// cycle and neither is a bridge.
+//
+// Multigraph is a separate type rather than a mode of WeightedGraph, and it
+// has only what is listed here and the package functions taking a
+// Traversable or WeightedTraversable. It has a single representation, so
+// there is no ConvertTo, and it has no attributes, transactions or undo,
+// Clone or Snapshot, iterators, or concurrent wrapper. Freeze accepts it but
+// keeps only the lightest of each set of parallel edges.
+type Multigraph[T comparable, W Number] struct {
+	graphType GraphType
+
+	nodes map[T]struct{}
+	edges map[EdgeID]MultiEdge[T, W]
+
+	out     map[T][]EdgeID    // edges leaving a node; every incident edge when undirected
+	in      map[T][]EdgeID    // edges entering a node, directed graphs only
+	between map[[2]T][]EdgeID // parallel edges by endpoints, both orientations when undirected
+	nextID  EdgeID
+}
+
+var _ WeightedTraversable[int, float64] = (*Multigraph[int, float64])(nil)
+
+func NewMultigraph[T comparable, W Number](graphType GraphType) *Multigraph[T, W] {
+	return &Multigraph[T, W]{
+		graphType: graphType,
+		nodes:     make(map[T]struct{}),
+		edges:     make(map[EdgeID]MultiEdge[T, W]),
+		out:       make(map[T][]EdgeID),
+		in:        make(map[T][]EdgeID),
+		between:   make(map[[2]T][]EdgeID),
+	}
+}
+
+func (g *Multigraph[T, W]) IsDirected() bool {
+	return g.graphType == Directed
+}
+
+func (g *Multigraph[T, W]) AddNode(node T) {
+	g.nodes[node] = struct{}{}
+}
+
+// RemoveNode removes node and every edge touching it. It returns an error
+// wrapping ErrNodeNotFound if node is not in the graph.
+func (g *Multigraph[T, W]) RemoveNode(node T) error {
+	if err := requireNodes[T](g, node); err != nil {
+		return err
+	}
+	incident := append(append([]EdgeID{}, g.out[node]...), g.in[node]...)
+	for _, id := range incident {
+		if _, ok := g.edges[id]; ok {
+			g.removeEdge(id)
+		}
+	}
+	delete(g.nodes, node)
+	delete(g.out, node)
+	delete(g.in, node)
+	return nil
+}
+
+// AddEdge adds a new edge from from to to, adding missing nodes, and returns
+// its ID. Existing edges between the same nodes are kept.
+func (g *Multigraph[T, W]) AddEdge(from T, to T, weight W) EdgeID {
+	g.AddNode(from)
+	g.AddNode(to)
+	id := g.nextID
+	g.nextID++
+	g.edges[id] = MultiEdge[T, W]{ID: id, Edge: [2]T{from, to}, Weight: weight}
+	g.out[from] = append(g.out[from], id)
+	g.between[[2]T{from, to}] = append(g.between[[2]T{from, to}], id)
+	if from == to {
+		return id
+	}
+	if g.IsDirected() {
+		g.in[to] = append(g.in[to], id)
+	} else {
+		g.out[to] = append(g.out[to], id)
+		g.between[[2]T{to, from}] = append(g.between[[2]T{to, from}], id)
+	}
+	return id
+}
+
+// RemoveEdge removes the edge with the given ID, leaving its parallel edges
+// in place. It returns an error wrapping ErrEdgeNotFound for an unknown ID.
+func (g *Multigraph[T, W]) RemoveEdge(id EdgeID) error {
+	if _, ok := g.edges[id]; !ok {
+		return fmt.Errorf("%w: id %d", ErrEdgeNotFound, id)
+	}
+	g.removeEdge(id)
+	return nil
+}
+
+func (g *Multigraph[T, W]) removeEdge(id EdgeID) {
+	e := g.edges[id]
+	from, to := e.Edge[0], e.Edge[1]
+	delete(g.edges, id)
+	g.out[from] = removeEdgeID(g.out[from], id)
+	g.unlink([2]T{from, to}, id)
+	if from == to {
+		return
+	}
+	if g.IsDirected() {
+		g.in[to] = removeEdgeID(g.in[to], id)
+	} else {
+		g.out[to] = removeEdgeID(g.out[to], id)
+		g.unlink([2]T{to, from}, id)
+	}
+}
+
+func (g *Multigraph[T, W]) unlink(pair [2]T, id EdgeID) {
+	ids := removeEdgeID(g.between[pair], id)
+	if len(ids) == 0 {
+		delete(g.between, pair)
+	} else {
+		g.between[pair] = ids
+	}
+}
+
+func removeEdgeID(ids []EdgeID, id EdgeID) []EdgeID {
+	for i, other := range ids {
+		if other == id {
+			return append(ids[:i:i], ids[i+1:]...)
+		}
+	}
+	return ids
+}
+
+// Edge returns the edge with the given ID.
+func (g *Multigraph[T, W]) Edge(id EdgeID) (MultiEdge[T, W], bool) {
+	e, ok := g.edges[id]
+	return e, ok
+}
+
+// EdgesBetween returns the parallel edges from from to to in the order they
+// were added. For undirected graphs the edges are reported as stored, so
+// Edge may be {to, from}.
+func (g *Multigraph[T, W]) EdgesBetween(from T, to T) []MultiEdge[T, W] {
+	edges := []MultiEdge[T, W]{}
+	for _, id := range g.between[[2]T{from, to}] {
+		edges = append(edges, g.edges[id])
+	}
+	return edges
+}
+
+// Multiplicity returns the number of parallel edges from from to to.
+func (g *Multigraph[T, W]) Multiplicity(from T, to T) int {
+	return len(g.between[[2]T{from, to}])
+}
+
+func (g *Multigraph[T, W]) HasNode(node T) bool {
+	_, exists := g.nodes[node]
+	return exists
+}
+
+func (g *Multigraph[T, W]) HasEdge(from T, to T) bool {
+	return len(g.between[[2]T{from, to}]) > 0
+}
+
+// Weight returns the weight of the lightest edge from from to to.
+func (g *Multigraph[T, W]) Weight(from T, to T) (W, bool) {
+	id, ok := g.lightest(from, to)
+	if !ok {
+		return Infinity[W](), false
+	}
+	return g.edges[id].Weight, true
+}
+
+// lightest returns the ID of the lightest edge from from to to, preferring
+// the oldest among equal weights.
+func (g *Multigraph[T, W]) lightest(from T, to T) (EdgeID, bool) {
+	ids := g.between[[2]T{from, to}]
+	if len(ids) == 0 {
+		return 0, false
+	}
+	best := ids[0]
+	for _, id := range ids[1:] {
+		if g.edges[id].Weight < g.edges[best].Weight {
+			best = id
+		}
+	}
+	return best, true
+}
+
+func (g *Multigraph[T, W]) Nodes() []T {
+	elems := make([]T, 0, len(g.nodes))
+	for key := range g.nodes {
+		elems = append(elems, key)
+	}
+	return elems
+}
+
+// Neighbours returns each node reachable over one edge once, however many
+// parallel edges lead to it.
+func (g *Multigraph[T, W]) Neighbours(node T) []T {
+	nbrs := []T{}
+	seen := map[T]struct{}{}
+	for _, id := range g.out[node] {
+		nbr := g.other(id, node)
+		if _, ok := seen[nbr]; !ok {
+			seen[nbr] = struct{}{}
+			nbrs = append(nbrs, nbr)
+		}
+	}
+	return nbrs
+}
+
+// edgeNeighbours lists the far end of every edge leaving node, once per
+// edge, for the depth-first searches that must see parallel edges.
+func (g *Multigraph[T, W]) edgeNeighbours(node T) []T {
+	nbrs := make([]T, 0, len(g.out[node]))
+	for _, id := range g.out[node] {
+		nbrs = append(nbrs, g.other(id, node))
+	}
+	return nbrs
+}
+
+// other returns the end of edge id that is not node, or node for a self-loop.
+func (g *Multigraph[T, W]) other(id EdgeID, node T) T {
+	e := g.edges[id]
+	if e.Edge[0] == node {
+		return e.Edge[1]
+	}
+	return e.Edge[0]
+}
+
+// Edges returns every edge once, parallel edges included, ordered by ID.
+func (g *Multigraph[T, W]) Edges() []MultiEdge[T, W] {
+	edges := make([]MultiEdge[T, W], 0, len(g.edges))
+	for _, e := range g.edges {
+		edges = append(edges, e)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].ID < edges[j].ID
+	})
+	return edges
+}
+
+// OutDegree counts edges leaving node, parallel edges included. In an
+// undirected graph it equals Degree.
+func (g *Multigraph[T, W]) OutDegree(node T) int {
+	if !g.IsDirected() {
+		return g.Degree(node)
+	}
+	return len(g.out[node])
+}
+
+func (g *Multigraph[T, W]) InDegree(node T) int {
+	if !g.IsDirected() {
+		return g.Degree(node)
+	}
+	return len(g.in[node]) + len(g.between[[2]T{node, node}])
+}
+
+// Degree counts the edges touching node, parallel edges included. In an
+// undirected graph a self-loop counts twice, so the degrees add up to twice
+// the number of edges; in a directed graph Degree is OutDegree.
+func (g *Multigraph[T, W]) Degree(node T) int {
+	if g.IsDirected() {
+		return g.OutDegree(node)
+	}
+	return len(g.out[node]) + len(g.between[[2]T{node, node}])
+}
+
+func (g *Multigraph[T, W]) BFS(start T) []T {
+	order := []T{}
+	visited := map[T]struct{}{start: {}}
+	queue := []T{start}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		order = append(order, curr)
+		for _, nbr := range g.Neighbours(curr) {
+			if _, ok := visited[nbr]; !ok {
+				visited[nbr] = struct{}{}
+				queue = append(queue, nbr)
+			}
+		}
+	}
+	return order
+}
+
+func (g *Multigraph[T, W]) DFSRecursive(start T) []T {
+	order := []T{}
+	visited := map[T]struct{}{}
+	var dfs func(curr T)
+	dfs = func(curr T) {
+		visited[curr] = struct{}{}
+		order = append(order, curr)
+		for _, nbr := range g.Neighbours(curr) {
+			if _, ok := visited[nbr]; !ok {
+				dfs(nbr)
+			}
+		}
+	}
+	dfs(start)
+	return order
+}
+
+// DFSIterative visits nodes in the same order as DFSRecursive.
+func (g *Multigraph[T, W]) DFSIterative(start T) []T {
+	order := []T{}
+	visited := map[T]struct{}{}
+	stack := []T{start}
+	for len(stack) > 0 {
+		curr := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, done := visited[curr]; done {
+			continue
+		}
+		visited[curr] = struct{}{}
+		order = append(order, curr)
+		nbrs := g.Neighbours(curr)
+		for i := len(nbrs) - 1; i >= 0; i-- {
+			if _, done := visited[nbrs[i]]; !done {
+				stack = append(stack, nbrs[i])
+			}
+		}
+	}
+	return order
+}
+
+// BFSShortestPath returns a path with the fewest edges from source to
+// target, or an empty path if there is none.
+func (g *Multigraph[T, W]) BFSShortestPath(source T, target T) []T {
+	if !g.HasNode(source) || !g.HasNode(target) {
+		return []T{}
+	}
+	prev := map[T]T{}
+	visited := map[T]struct{}{source: {}}
+	queue := []T{source}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		if curr == target {
+			return buildPath(prev, source, target)
+		}
+		for _, nbr := range g.Neighbours(curr) {
+			if _, ok := visited[nbr]; !ok {
+				visited[nbr] = struct{}{}
+				prev[nbr] = curr
+				queue = append(queue, nbr)
+			}
+		}
+	}
+	return []T{}
+}
+
+func (g *Multigraph[T, W]) Dijkstra(source T) (map[T]W, map[T]T) {
+	return Dijkstra[T, W](g, source)
+}
+
+func (g *Multigraph[T, W]) DijkstraShortestPath(source T, target T) ([]T, W) {
+	return DijkstraShortestPath[T, W](g, source, target)
+}
+
+// ShortestPathEdges returns the IDs of the edges on a cheapest path from
+// source to target, taking the lightest of any parallel edges, and the path
+// cost. If target is unreachable it returns an empty slice and Infinity[W]().
+func (g *Multigraph[T, W]) ShortestPathEdges(source T, target T) ([]EdgeID, W) {
+	path, cost := g.DijkstraShortestPath(source, target)
+	ids := []EdgeID{}
+	for i := 1; i < len(path); i++ {
+		id, _ := g.lightest(path[i-1], path[i])
+		ids = append(ids, id)
+	}
+	return ids, cost
+}
@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestMultigraphParallelEdges(t *testing.T) {
+	m := NewMultigraph[string, int](Undirected)
+	first := m.AddEdge("a", "b", 5)
+	second := m.AddEdge("b", "a", 2)
+	m.AddEdge("b", "c", 1)
+
+	if first == second {
+		t.Fatalf("parallel edges share ID %d", first)
+	}
+	if got := m.Multiplicity("a", "b"); got != 2 {
+		t.Errorf("Multiplicity(a, b) = %d, want 2", got)
+	}
+	if w, ok := m.Weight("a", "b"); !ok || w != 2 {
+		t.Errorf("Weight(a, b) = %d, %v, want the lightest, 2", w, ok)
+	}
+	if got := len(m.Edges()); got != 3 {
+		t.Errorf("%d edges, want 3", got)
+	}
+	if got := len(m.Neighbours("a")); got != 1 {
+		t.Errorf("Neighbours(a) has %d entries, want 1", got)
+	}
+
+	if err := m.RemoveEdge(second); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.RemoveEdge(second); !errors.Is(err, ErrEdgeNotFound) {
+		t.Errorf("removing an edge twice: err = %v", err)
+	}
+	if e, ok := m.Edge(first); !ok || e.Weight != 5 {
+		t.Errorf("Edge(%d) = %v, %v after removing its twin", first, e, ok)
+	}
+	if w, _ := m.Weight("a", "b"); w != 5 {
+		t.Errorf("Weight(a, b) = %d after removing the lighter edge, want 5", w)
+	}
+}
+
+func TestMultigraphDegrees(t *testing.T) {
+	tests := []struct {
+		name      string
+		graphType GraphType
+		node      string
+		out, in   int
+		degree    int
+	}{
+		{"undirected loop node", Undirected, "a", 5, 5, 5},
+		{"undirected plain node", Undirected, "b", 2, 2, 2},
+		{"directed loop node", Directed, "a", 3, 2, 3},
+		{"directed plain node", Directed, "b", 0, 2, 0},
+	}
+	for _, tt := range tests {
+		m := NewMultigraph[string, int](tt.graphType)
+		m.AddEdge("a", "a", 1)
+		m.AddEdge("a", "b", 1)
+		m.AddEdge("a", "b", 1)
+		m.AddEdge("c", "a", 1)
+		if got := m.OutDegree(tt.node); got != tt.out {
+			t.Errorf("%s: OutDegree = %d, want %d", tt.name, got, tt.out)
+		}
+		if got := m.InDegree(tt.node); got != tt.in {
+			t.Errorf("%s: InDegree = %d, want %d", tt.name, got, tt.in)
+		}
+		if got := m.Degree(tt.node); got != tt.degree {
+			t.Errorf("%s: Degree = %d, want %d", tt.name, got, tt.degree)
+		}
+	}
+
+	m := NewMultigraph[int, int](Undirected)
+	m.AddEdge(1, 1, 1)
+	m.AddEdge(1, 2, 1)
+	m.AddEdge(2, 1, 1)
+	m.AddEdge(2, 3, 1)
+	sum := 0
+	for _, node := range m.Nodes() {
+		sum += m.Degree(node)
+	}
+	if sum != 2*len(m.Edges()) {
+		t.Errorf("degrees sum to %d, want twice the %d edges", sum, len(m.Edges()))
+	}
+}
+
+func TestMultigraphTraversableAlgorithms(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][2]string
+		bridges  int
+		cycle    bool
+		twoEdged int
+	}{
+		{"single edge", [][2]string{{"a", "b"}}, 1, false, 2},
+		{"parallel pair", [][2]string{{"a", "b"}, {"a", "b"}}, 0, true, 1},
+		{"parallel pair and tail", [][2]string{{"a", "b"}, {"b", "a"}, {"b", "c"}}, 1, true, 2},
+		{"self-loop", [][2]string{{"a", "a"}, {"a", "b"}}, 1, true, 2},
+	}
+	for _, tt := range tests {
+		m := NewMultigraph[string, int](Undirected)
+		for _, e := range tt.edges {
+			m.AddEdge(e[0], e[1], 1)
+		}
+		if got := Bridges[string](m); len(got) != tt.bridges {
+			t.Errorf("%s: Bridges = %v, want %d", tt.name, got, tt.bridges)
+		}
+		if got := HasCycle[string](m); got != tt.cycle {
+			t.Errorf("%s: HasCycle = %v, want %v", tt.name, got, tt.cycle)
+		}
+		if got := TwoEdgeConnectedComponents[string](m); len(got) != tt.twoEdged {
+			t.Errorf("%s: TwoEdgeConnectedComponents = %v, want %d components", tt.name, got, tt.twoEdged)
+		}
+	}
+
+	d := NewMultigraph[string, int](Directed)
+	d.AddEdge("a", "b", 1)
+	d.AddEdge("a", "b", 1)
+	if HasCycle[string](d) {
+		t.Errorf("directed parallel edges reported as a cycle")
+	}
+}
+
+func TestMultigraphShortestPathEdges(t *testing.T) {
+	m := NewMultigraph[string, float64](Directed)
+	m.AddEdge("a", "b", 4)
+	cheap := m.AddEdge("a", "b", 1.5)
+	m.AddEdge("b", "c", 3)
+	last := m.AddEdge("b", "c", 2)
+	m.AddEdge("a", "c", 10)
+	m.AddNode("z")
+
+	ids, cost := m.ShortestPathEdges("a", "c")
+	if !slices.Equal(ids, []EdgeID{cheap, last}) || cost != 3.5 {
+		t.Errorf("ShortestPathEdges(a, c) = %v, %v, want [%d %d], 3.5", ids, cost, cheap, last)
+	}
+	ids, cost = m.ShortestPathEdges("a", "z")
+	if len(ids) != 0 || cost != Infinity[float64]() {
+		t.Errorf("ShortestPathEdges(a, z) = %v, %v, want no edges and +Inf", ids, cost)
+	}
+	if path := m.BFSShortestPath("a", "c"); !slices.Equal(path, []string{"a", "c"}) {
+		t.Errorf("BFSShortestPath(a, c) = %v", path)
+	}
+}
@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// isPath reports whether path is a simple path from source to target in g.
+func isPath(g *Graph[string], path []string, source string, target string) bool {
+	if len(path) == 0 || path[0] != source || path[len(path)-1] != target {
+		return false
+	}
+	seen := map[string]bool{}
+	for i, node := range path {
+		if seen[node] || (i > 0 && !g.HasEdge(path[i-1], node)) {
+			return false
+		}
+		seen[node] = true
+	}
+	return true
+}
+
+func TestPathFinding(t *testing.T) {
+	for _, repType := range []RepresentationType{AdjacencyList, AdjacencyMatrix} {
+		g := NewGraph[string](Directed, repType)
+		for _, e := range [][2]string{{"a", "b"}, {"b", "c"}, {"a", "c"}, {"c", "d"}, {"d", "b"}} {
+			g.AddEdge(e[0], e[1])
+		}
+		g.AddNode("e")
+
+		if got := g.DFSRecursive("a"); len(got) != 4 {
+			t.Errorf("%v: DFSRecursive(a) = %v, want 4 nodes", repType, got)
+		}
+		if got, want := g.BFSShortestPath("a", "d"), []string{"a", "c", "d"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%v: BFSShortestPath(a, d) = %v, want %v", repType, got, want)
+		}
+		for name, path := range map[string][]string{
+			"RecursiveDFSAnyPathFinding": g.RecursiveDFSAnyPathFinding("a", "d"),
+			"DFSIterativeAnyPathFinding": g.DFSIterativeAnyPathFinding("a", "d"),
+		} {
+			if !isPath(g, path, "a", "d") {
+				t.Errorf("%v: %s(a, d) = %v, not a path", repType, name, path)
+			}
+		}
+		for name, paths := range map[string][][]string{
+			"RecursiveDFSAllPathFinding": g.RecursiveDFSAllPathFinding("a", "d"),
+			"DFSIterativeAllPathFinding": g.DFSIterativeAllPathFinding("a", "d"),
+		} {
+			got := []string{}
+			for _, path := range paths {
+				if !isPath(g, path, "a", "d") {
+					t.Errorf("%v: %s(a, d) returned %v, not a path", repType, name, path)
+				}
+				got = append(got, strings.Join(path, ""))
+			}
+			sort.Strings(got)
+			if want := []string{"abcd", "acd"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("%v: %s(a, d) = %v, want %v", repType, name, got, want)
+			}
+		}
+		if got := g.DFSIterativeAnyPathFinding("a", "e"); len(got) != 0 {
+			t.Errorf("%v: DFSIterativeAnyPathFinding(a, e) = %v, want none", repType, got)
+		}
+	}
+}
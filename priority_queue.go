@@ -0,0 +1,34 @@
+package graph
+
+// priorityQueue is a binary min-heap of nodes keyed by priority, used with
+// container/heap by the shortest path and spanning tree algorithms.
+type priorityQueue[T comparable, W Number] []pqItem[T, W]
+
+type pqItem[T comparable, W Number] struct {
+	node     T
+	priority W
+}
+
+func (pq priorityQueue[T, W]) Len() int {
+	return len(pq)
+}
+
+func (pq priorityQueue[T, W]) Less(i, j int) bool {
+	return pq[i].priority < pq[j].priority
+}
+
+func (pq priorityQueue[T, W]) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+}
+
+func (pq *priorityQueue[T, W]) Push(x any) {
+	*pq = append(*pq, x.(pqItem[T, W]))
+}
+
+func (pq *priorityQueue[T, W]) Pop() any {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[:n-1]
+	return item
+}
@@ -0,0 +1,223 @@
+package graph
+
+func (g *Graph[T]) StronglyConnectedComponents() [][]T {
+	return StronglyConnectedComponents[T](g)
+}
+
+func (g *Graph[T]) StronglyConnectedComponentsKosaraju() [][]T {
+	return StronglyConnectedComponentsKosaraju[T](g)
+}
+
+func (g *Graph[T]) Condensation() (*Graph[int], map[T]int) {
+	return condensation[T](g, g.repType)
+}
+
+func (g *WeightedGraph[T, W]) StronglyConnectedComponents() [][]T {
+	return StronglyConnectedComponents[T](g)
+}
+
+func (g *WeightedGraph[T, W]) StronglyConnectedComponentsKosaraju() [][]T {
+	return StronglyConnectedComponentsKosaraju[T](g)
+}
+
+func (g *WeightedGraph[T, W]) Condensation() (*WeightedGraph[int, W], map[T]int) {
+	return weightedCondensation[T, W](g, g.repType)
+}
+
+// StronglyConnectedComponents returns the strongly connected components of
+// the graph using an iterative Tarjan's algorithm. Components are listed in
+// reverse topological order of the condensation (sinks first).
+func StronglyConnectedComponents[T comparable](g Traversable[T]) [][]T {
+	return tarjanSCC(g.Nodes(), g.Neighbours)
+}
+
+// StronglyConnectedComponentsKosaraju returns the strongly connected
+// components of the graph using Kosaraju's algorithm. Components are listed
+// in topological order of the condensation (sources first).
+func StronglyConnectedComponentsKosaraju[T comparable](g Traversable[T]) [][]T {
+	return kosarajuSCC(g.Nodes(), g.Neighbours)
+}
+
+// Condensation contracts every strongly connected component into a single
+// node and returns the resulting DAG, whose nodes are component ids, together
+// with the component id of every node. Component i is
+// StronglyConnectedComponents(g)[i].
+func Condensation[T comparable](g Traversable[T]) (*Graph[int], map[T]int) {
+	return condensation(g, AdjacencyList)
+}
+
+// WeightedCondensation is Condensation for weighted graphs. The edge between
+// two components carries the cheapest weight among the edges joining them.
+func WeightedCondensation[T comparable, W Number](g WeightedTraversable[T, W]) (*WeightedGraph[int, W], map[T]int) {
+	return weightedCondensation(g, AdjacencyList)
+}
+
+func condensation[T comparable](g Traversable[T], repType RepresentationType) (*Graph[int], map[T]int) {
+	components := StronglyConnectedComponents(g)
+	componentOf := componentIndex(components)
+	dag := NewGraph[int](Directed, repType)
+	for i := range components {
+		dag.AddNode(i)
+	}
+	for _, from := range g.Nodes() {
+		for _, to := range g.Neighbours(from) {
+			if componentOf[from] != componentOf[to] {
+				dag.AddEdge(componentOf[from], componentOf[to])
+			}
+		}
+	}
+	return dag, componentOf
+}
+
+func weightedCondensation[T comparable, W Number](g WeightedTraversable[T, W], repType RepresentationType) (*WeightedGraph[int, W], map[T]int) {
+	components := StronglyConnectedComponents[T](g)
+	componentOf := componentIndex(components)
+	dag := NewWeightedGraph[int, W](Directed, repType)
+	for i := range components {
+		dag.AddNode(i)
+	}
+	for _, e := range arcs(g) {
+		from, to := componentOf[e.Edge[0]], componentOf[e.Edge[1]]
+		if from == to {
+			continue
+		}
+		if weight, ok := dag.Weight(from, to); !ok || e.Weight < weight {
+			dag.AddEdge(from, to, e.Weight)
+		}
+	}
+	return dag, componentOf
+}
+
+func componentIndex[T comparable](components [][]T) map[T]int {
+	componentOf := map[T]int{}
+	for i, component := range components {
+		for _, node := range component {
+			componentOf[node] = i
+		}
+	}
+	return componentOf
+}
+
+// tarjanSCC runs Tarjan's algorithm with an explicit call stack so that long
+// chains do not exhaust the goroutine stack.
+func tarjanSCC[T comparable](nodes []T, neighbours func(T) []T) [][]T {
+	type frame struct {
+		node T
+		nbrs []T
+		next int
+	}
+	index := map[T]int{}
+	low := map[T]int{}
+	onStack := map[T]struct{}{}
+	stack := []T{}
+	components := [][]T{}
+	counter := 0
+
+	for _, root := range nodes {
+		if _, seen := index[root]; seen {
+			continue
+		}
+		callStack := []*frame{}
+		visit := func(node T) {
+			index[node] = counter
+			low[node] = counter
+			counter++
+			stack = append(stack, node)
+			onStack[node] = struct{}{}
+			callStack = append(callStack, &frame{node: node, nbrs: neighbours(node)})
+		}
+		visit(root)
+		for len(callStack) > 0 {
+			top := callStack[len(callStack)-1]
+			if top.next < len(top.nbrs) {
+				nbr := top.nbrs[top.next]
+				top.next++
+				if _, seen := index[nbr]; !seen {
+					visit(nbr)
+				} else if _, ok := onStack[nbr]; ok {
+					low[top.node] = min(low[top.node], index[nbr])
+				}
+				continue
+			}
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].node
+				low[parent] = min(low[parent], low[top.node])
+			}
+			if low[top.node] == index[top.node] {
+				component := []T{}
+				for {
+					node := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					delete(onStack, node)
+					component = append(component, node)
+					if node == top.node {
+						break
+					}
+				}
+				components = append(components, component)
+			}
+		}
+	}
+	return components
+}
+
+// kosarajuSCC orders the nodes by DFS finishing time, then collects
+// components by searching the reversed graph in decreasing finishing time.
+func kosarajuSCC[T comparable](nodes []T, neighbours func(T) []T) [][]T {
+	type frame struct {
+		node T
+		nbrs []T
+		next int
+	}
+	visited := map[T]struct{}{}
+	finished := []T{}
+	reverse := map[T][]T{}
+	for _, root := range nodes {
+		if _, seen := visited[root]; seen {
+			continue
+		}
+		visited[root] = struct{}{}
+		callStack := []*frame{{node: root, nbrs: neighbours(root)}}
+		for len(callStack) > 0 {
+			top := callStack[len(callStack)-1]
+			if top.next < len(top.nbrs) {
+				nbr := top.nbrs[top.next]
+				top.next++
+				reverse[nbr] = append(reverse[nbr], top.node)
+				if _, seen := visited[nbr]; !seen {
+					visited[nbr] = struct{}{}
+					callStack = append(callStack, &frame{node: nbr, nbrs: neighbours(nbr)})
+				}
+				continue
+			}
+			callStack = callStack[:len(callStack)-1]
+			finished = append(finished, top.node)
+		}
+	}
+
+	assigned := map[T]struct{}{}
+	components := [][]T{}
+	for i := len(finished) - 1; i >= 0; i-- {
+		root := finished[i]
+		if _, done := assigned[root]; done {
+			continue
+		}
+		assigned[root] = struct{}{}
+		component := []T{}
+		stack := []T{root}
+		for len(stack) > 0 {
+			curr := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			component = append(component, curr)
+			for _, nbr := range reverse[curr] {
+				if _, done := assigned[nbr]; !done {
+					assigned[nbr] = struct{}{}
+					stack = append(stack, nbr)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
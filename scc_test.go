@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func buildGraph(graphType GraphType, repType RepresentationType, edges [][2]string) *Graph[string] {
+	g := NewGraph[string](graphType, repType)
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+// normalizeComponents sorts every component and then the components, so
+// results can be compared regardless of visiting order.
+func normalizeComponents(components [][]string) []string {
+	out := make([]string, len(components))
+	for i, component := range components {
+		sorted := slices.Clone(component)
+		slices.Sort(sorted)
+		out[i] = strings.Join(sorted, "")
+	}
+	slices.Sort(out)
+	return out
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	tests := []struct {
+		name       string
+		edges      [][2]string
+		components []string
+	}{
+		{
+			name:       "three cycles in a chain",
+			edges:      [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}, {"d", "e"}, {"e", "d"}, {"e", "f"}, {"f", "f"}},
+			components: []string{"abc", "de", "f"},
+		},
+		{
+			name:       "dag",
+			edges:      [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}},
+			components: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:       "one big cycle",
+			edges:      [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "a"}, {"b", "d"}},
+			components: []string{"abcd"},
+		},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := buildGraph(Directed, repType, tt.edges)
+			tarjan := g.StronglyConnectedComponents()
+			kosaraju := g.StronglyConnectedComponentsKosaraju()
+			if got := normalizeComponents(tarjan); !slices.Equal(got, tt.components) {
+				t.Errorf("%s/%v: Tarjan = %v, want %v", tt.name, repType, got, tt.components)
+			}
+			if got := normalizeComponents(kosaraju); !slices.Equal(got, tt.components) {
+				t.Errorf("%s/%v: Kosaraju = %v, want %v", tt.name, repType, got, tt.components)
+			}
+			tarjanOf, kosarajuOf := componentIndex(tarjan), componentIndex(kosaraju)
+			for _, e := range g.Edges() {
+				from, to := e[0], e[1]
+				if tarjanOf[from] != tarjanOf[to] && tarjanOf[from] < tarjanOf[to] {
+					t.Errorf("%s/%v: Tarjan lists %s's component before %s's", tt.name, repType, from, to)
+				}
+				if kosarajuOf[from] != kosarajuOf[to] && kosarajuOf[from] > kosarajuOf[to] {
+					t.Errorf("%s/%v: Kosaraju lists %s's component after %s's", tt.name, repType, from, to)
+				}
+			}
+		}
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	edges := [][2]string{{"a", "b"}, {"b", "a"}, {"b", "c"}, {"a", "c"}, {"c", "d"}, {"d", "c"}}
+	for _, repType := range representations {
+		g := buildGraph(Directed, repType, edges)
+		dag, componentOf := g.Condensation()
+		if len(dag.Nodes()) != 2 || len(dag.Edges()) != 1 {
+			t.Fatalf("%v: condensation has nodes %v, edges %v", repType, dag.Nodes(), dag.Edges())
+		}
+		if !dag.HasEdge(componentOf["a"], componentOf["c"]) || componentOf["a"] != componentOf["b"] {
+			t.Errorf("%v: wrong components %v", repType, componentOf)
+		}
+		if HasCycle[int](dag) {
+			t.Errorf("%v: condensation has a cycle", repType)
+		}
+	}
+}
+
+func TestWeightedCondensation(t *testing.T) {
+	edges := []weightedEdgeCase{{"a", "b", 1}, {"b", "a", 1}, {"a", "c", 7}, {"b", "c", 3}, {"c", "d", 2}}
+	for _, repType := range representations {
+		g := buildWeighted(Directed, repType, edges)
+		dag, componentOf := g.Condensation()
+		if w, ok := dag.Weight(componentOf["a"], componentOf["c"]); !ok || w != 3 {
+			t.Errorf("%v: ab -> c weighs %d, %v, want the cheaper 3", repType, w, ok)
+		}
+		if w, ok := dag.Weight(componentOf["c"], componentOf["d"]); !ok || w != 2 {
+			t.Errorf("%v: c -> d weighs %d, %v, want 2", repType, w, ok)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+)
+
+// The iterators below stream their results lazily and stop as soon as the
+// loop body breaks. The graph must not be modified while one is running.
+
+// AllNodes yields every node, in Nodes() order for AdjacencyMatrix graphs.
+func (g *Graph[T]) AllNodes() iter.Seq[T] {
+	if g.repType == AdjacencyMatrix {
+		return sliceSeq(g.indexToNodes)
+	}
+	return setSeq(g.nodes)
+}
+
+// AllEdges yields every edge like Edges, listing undirected edges once.
+func (g *Graph[T]) AllEdges() iter.Seq[[2]T] {
+	return func(yield func([2]T) bool) {
+		if g.repType == AdjacencyMatrix {
+			for i, row := range g.adjMatrix {
+				for k, ok := range row {
+					if ok && (g.graphType == Directed || i <= k) && !yield([2]T{g.indexToNodes[i], g.indexToNodes[k]}) {
+						return
+					}
+				}
+			}
+			return
+		}
+		seen := map[[2]T]struct{}{}
+		for from, nbrs := range g.adjList {
+			for to := range nbrs {
+				if g.graphType == Undirected {
+					if _, ok := seen[[2]T{to, from}]; ok {
+						continue
+					}
+					seen[[2]T{from, to}] = struct{}{}
+				}
+				if !yield([2]T{from, to}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (g *Graph[T]) NeighboursSeq(node T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if g.repType == AdjacencyList {
+			for nbr := range g.adjList[node] {
+				if !yield(nbr) {
+					return
+				}
+			}
+			return
+		}
+		index, ok := g.nodesToIndex[node]
+		if !ok {
+			return
+		}
+		for i, edge := range g.adjMatrix[index] {
+			if edge && !yield(g.indexToNodes[i]) {
+				return
+			}
+		}
+	}
+}
+
+// BFSSeq yields nodes in the order BFS lists them, as they are discovered.
+func (g *Graph[T]) BFSSeq(start T) iter.Seq[T] {
+	return bfsSeq(start, g.NeighboursSeq)
+}
+
+// DFSSeq yields nodes in depth-first preorder, the order DFSRecursive lists
+// them in.
+func (g *Graph[T]) DFSSeq(start T) iter.Seq[T] {
+	return dfsSeq(start, g.NeighboursSeq)
+}
+
+func (g *WeightedGraph[T, W]) AllNodes() iter.Seq[T] {
+	if g.repType == AdjacencyMatrix {
+		return sliceSeq(g.indexToNodes)
+	}
+	return setSeq(g.nodes)
+}
+
+// AllEdges yields every edge with its weight, listing undirected edges once.
+func (g *WeightedGraph[T, W]) AllEdges() iter.Seq2[[2]T, W] {
+	return func(yield func([2]T, W) bool) {
+		if g.repType == AdjacencyMatrix {
+			for i, row := range g.hasEdge {
+				for k, ok := range row {
+					if ok && (g.graphType == Directed || i <= k) && !yield([2]T{g.indexToNodes[i], g.indexToNodes[k]}, g.adjMatrix[i][k]) {
+						return
+					}
+				}
+			}
+			return
+		}
+		seen := map[[2]T]struct{}{}
+		for from, nbrs := range g.adjList {
+			for to, weight := range nbrs {
+				if g.graphType == Undirected {
+					if _, ok := seen[[2]T{to, from}]; ok {
+						continue
+					}
+					seen[[2]T{from, to}] = struct{}{}
+				}
+				if !yield([2]T{from, to}, weight) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// NeighboursSeq yields every neighbour of node with the weight of the edge
+// leading to it.
+func (g *WeightedGraph[T, W]) NeighboursSeq(node T) iter.Seq2[T, W] {
+	return func(yield func(T, W) bool) {
+		if g.repType == AdjacencyList {
+			for nbr, weight := range g.adjList[node] {
+				if !yield(nbr, weight) {
+					return
+				}
+			}
+			return
+		}
+		index, ok := g.nodesToIndex[node]
+		if !ok {
+			return
+		}
+		for i, edge := range g.hasEdge[index] {
+			if edge && !yield(g.indexToNodes[i], g.adjMatrix[index][i]) {
+				return
+			}
+		}
+	}
+}
+
+func (g *WeightedGraph[T, W]) BFSSeq(start T) iter.Seq[T] {
+	return bfsSeq(start, g.neighbourNodes)
+}
+
+func (g *WeightedGraph[T, W]) DFSSeq(start T) iter.Seq[T] {
+	return dfsSeq(start, g.neighbourNodes)
+}
+
+// neighbourNodes is NeighboursSeq without the weights.
+func (g *WeightedGraph[T, W]) neighbourNodes(node T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for nbr := range g.NeighboursSeq(node) {
+			if !yield(nbr) {
+				return
+			}
+		}
+	}
+}
+
+func sliceSeq[T any](elems []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, elem := range elems {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+func setSeq[T comparable](set map[T]struct{}) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for elem := range set {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// bfsSeq yields start and then every node reachable from it in breadth-first
+// order, reading each node's neighbours only once it is dequeued.
+func bfsSeq[T comparable](start T, neighbours func(T) iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if !yield(start) {
+			return
+		}
+		visited := map[T]struct{}{start: {}}
+		queue := []T{start}
+		for len(queue) > 0 {
+			curr := queue[0]
+			queue = queue[1:]
+			for nbr := range neighbours(curr) {
+				if _, ok := visited[nbr]; ok {
+					continue
+				}
+				visited[nbr] = struct{}{}
+				if !yield(nbr) {
+					return
+				}
+				queue = append(queue, nbr)
+			}
+		}
+	}
+}
+
+// dfsSeq yields nodes in depth-first preorder from start, collecting a
+// node's neighbours only when the search reaches it.
+func dfsSeq[T comparable](start T, neighbours func(T) iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		type frame struct {
+			nbrs []T
+			next int
+		}
+		visited := map[T]struct{}{start: {}}
+		if !yield(start) {
+			return
+		}
+		stack := []*frame{{nbrs: slices.Collect(neighbours(start))}}
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.next == len(top.nbrs) {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			nbr := top.nbrs[top.next]
+			top.next++
+			if _, done := visited[nbr]; done {
+				continue
+			}
+			visited[nbr] = struct{}{}
+			if !yield(nbr) {
+				return
+			}
+			stack = append(stack, &frame{nbrs: slices.Collect(neighbours(nbr))})
+		}
+	}
+}
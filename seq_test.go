@@ -0,0 +1,166 @@
+package graph
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+)
+
+var seqEdges = [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}, {"d", "e"}, {"e", "e"}, {"f", "g"}}
+
+func TestGraphSeq(t *testing.T) {
+	for _, graphType := range []GraphType{Directed, Undirected} {
+		for _, repType := range representations {
+			g := buildGraph(graphType, repType, seqEdges)
+
+			nodes := slices.Collect(g.AllNodes())
+			if repType == AdjacencyMatrix && !slices.Equal(nodes, g.Nodes()) {
+				t.Errorf("%v/%v: AllNodes = %v, want Nodes order %v", graphType, repType, nodes, g.Nodes())
+			}
+			if !sameElements(nodes, g.Nodes()) {
+				t.Errorf("%v/%v: AllNodes = %v, want %v", graphType, repType, nodes, g.Nodes())
+			}
+			edges := slices.Collect(g.AllEdges())
+			if !sameElements(orientEdges(graphType, edges), orientEdges(graphType, g.Edges())) {
+				t.Errorf("%v/%v: AllEdges = %v, want %v", graphType, repType, edges, g.Edges())
+			}
+			for _, node := range append(g.Nodes(), "missing") {
+				if nbrs := slices.Collect(g.NeighboursSeq(node)); !sameElements(nbrs, g.Neighbours(node)) {
+					t.Errorf("%v/%v: NeighboursSeq(%s) = %v, want %v", graphType, repType, node, nbrs, g.Neighbours(node))
+				}
+			}
+
+			bfs, dfs := slices.Collect(g.BFSSeq("a")), slices.Collect(g.DFSSeq("a"))
+			if !sameElements(bfs, g.BFS("a")) || !sameElements(dfs, g.BFS("a")) {
+				t.Errorf("%v/%v: BFSSeq = %v, DFSSeq = %v, want the nodes of %v", graphType, repType, bfs, dfs, g.BFS("a"))
+			}
+			if repType == AdjacencyMatrix {
+				if !slices.Equal(bfs, g.BFS("a")) {
+					t.Errorf("%v: BFSSeq = %v, want BFS order %v", graphType, bfs, g.BFS("a"))
+				}
+				if !slices.Equal(dfs, g.DFSRecursive("a")) {
+					t.Errorf("%v: DFSSeq = %v, want DFSRecursive order %v", graphType, dfs, g.DFSRecursive("a"))
+				}
+			}
+			checkBFSOrder(t, g, "a", bfs)
+		}
+	}
+}
+
+func TestWeightedGraphSeq(t *testing.T) {
+	edges := []weightedEdgeCase{{"a", "b", 1}, {"b", "c", 2}, {"c", "a", 3}, {"c", "c", 4}, {"d", "e", 5}}
+	for _, graphType := range []GraphType{Directed, Undirected} {
+		for _, repType := range representations {
+			g := buildWeighted(graphType, repType, edges)
+			got := map[[2]string]int{}
+			for edge, weight := range g.AllEdges() {
+				got[orientEdges(graphType, [][2]string{edge})[0]] = weight
+			}
+			want := map[[2]string]int{}
+			for _, e := range g.Edges() {
+				want[orientEdges(graphType, [][2]string{e.Edge})[0]] = e.Weight
+			}
+			if !maps.Equal(got, want) {
+				t.Errorf("%v/%v: AllEdges = %v, want %v", graphType, repType, got, want)
+			}
+			for nbr, weight := range g.NeighboursSeq("c") {
+				if w, _ := g.Weight("c", nbr); w != weight {
+					t.Errorf("%v/%v: NeighboursSeq(c) gives %s weight %d, want %d", graphType, repType, nbr, weight, w)
+				}
+			}
+			if nodes := slices.Collect(g.AllNodes()); !sameElements(nodes, g.Nodes()) {
+				t.Errorf("%v/%v: AllNodes = %v", graphType, repType, nodes)
+			}
+			if bfs := slices.Collect(g.BFSSeq("a")); !sameElements(bfs, g.BFS("a")) {
+				t.Errorf("%v/%v: BFSSeq = %v, want the nodes of %v", graphType, repType, bfs, g.BFS("a"))
+			}
+			if dfs := slices.Collect(g.DFSSeq("d")); !sameElements(dfs, g.BFS("d")) {
+				t.Errorf("%v/%v: DFSSeq = %v, want the nodes of %v", graphType, repType, dfs, g.BFS("d"))
+			}
+		}
+	}
+}
+
+func TestSeqBreak(t *testing.T) {
+	for _, repType := range representations {
+		g := buildGraph(Undirected, repType, seqEdges)
+		w := buildWeighted(Undirected, repType, []weightedEdgeCase{{"a", "b", 1}, {"b", "c", 1}, {"c", "a", 1}})
+		seqs := map[string]iter.Seq[string]{
+			"AllNodes":        g.AllNodes(),
+			"NeighboursSeq":   g.NeighboursSeq("d"),
+			"BFSSeq":          g.BFSSeq("a"),
+			"DFSSeq":          g.DFSSeq("a"),
+			"weighted BFSSeq": w.BFSSeq("a"),
+			"weighted DFSSeq": w.DFSSeq("a"),
+		}
+		for name, seq := range seqs {
+			n := 0
+			for range seq {
+				n++
+				if n == 2 {
+					break
+				}
+			}
+			if n != 2 {
+				t.Errorf("%v: %s yielded %d nodes before the break", repType, name, n)
+			}
+		}
+		n := 0
+		for range g.AllEdges() {
+			n++
+			break
+		}
+		for range w.AllEdges() {
+			n++
+			break
+		}
+		if n != 2 {
+			t.Errorf("%v: AllEdges kept going after a break", repType)
+		}
+	}
+}
+
+// sameElements reports whether a and b hold the same elements, ignoring
+// order.
+func sameElements[E comparable](a, b []E) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := map[E]int{}
+	for _, e := range a {
+		count[e]++
+	}
+	for _, e := range b {
+		count[e]--
+	}
+	for _, c := range count {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// orientEdges returns edges with undirected ones pointing from the smaller
+// node, so that edge lists from different iterations compare equal.
+func orientEdges(graphType GraphType, edges [][2]string) [][2]string {
+	out := slices.Clone(edges)
+	for i, e := range out {
+		if graphType == Undirected && e[0] > e[1] {
+			out[i] = [2]string{e[1], e[0]}
+		}
+	}
+	return out
+}
+
+// checkBFSOrder fails t unless order visits nodes in non-decreasing distance
+// from start.
+func checkBFSOrder(t *testing.T, g *Graph[string], start string, order []string) {
+	t.Helper()
+	for i := 1; i < len(order); i++ {
+		if len(g.BFSShortestPath(start, order[i-1])) > len(g.BFSShortestPath(start, order[i])) {
+			t.Errorf("BFS order %v visits %s before the closer %s", order, order[i-1], order[i])
+		}
+	}
+}
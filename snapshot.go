@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"maps"
+	"slices"
+)
+
+// Clone returns a deep copy of g sharing no storage with it. Attribute values
+// themselves are shared. The copy starts without undo history.
+func (g *Graph[T]) Clone() *Graph[T] {
+	c := &Graph[T]{
+		graphType:    g.graphType,
+		repType:      g.repType,
+		nodes:        maps.Clone(g.nodes),
+		adjList:      make(map[T]map[T]struct{}, len(g.adjList)),
+		nodesToIndex: maps.Clone(g.nodesToIndex),
+		indexToNodes: slices.Clone(g.indexToNodes),
+		adjMatrix:    make([][]bool, len(g.adjMatrix)),
+		attrs:        g.attrs.clone(),
+	}
+	for node, nbrs := range g.adjList {
+		c.adjList[node] = maps.Clone(nbrs)
+	}
+	for i, row := range g.adjMatrix {
+		c.adjMatrix[i] = slices.Clone(row)
+	}
+	return c
+}
+
+// Snapshot returns a point-in-time view of g in O(1). The view and g share
+// their storage until either of them is modified; the first change to either
+// side copies it, as Clone does, so the other never sees it. Algorithms can
+// run on a snapshot while g keeps changing. The undo history stays with g.
+func (g *Graph[T]) Snapshot() *Graph[T] {
+	g.shared = true
+	s := *g
+	s.log = nil
+	return &s
+}
+
+// unshare gives g its own storage if a snapshot may still be using it. Every
+// mutation calls it first.
+func (g *Graph[T]) unshare() {
+	if g.shared {
+		log := g.log
+		*g = *g.Clone()
+		g.log = log
+	}
+}
+
+func (g *WeightedGraph[T, W]) Clone() *WeightedGraph[T, W] {
+	c := &WeightedGraph[T, W]{
+		graphType:    g.graphType,
+		repType:      g.repType,
+		nodes:        maps.Clone(g.nodes),
+		adjList:      make(map[T]map[T]W, len(g.adjList)),
+		nodesToIndex: maps.Clone(g.nodesToIndex),
+		indexToNodes: slices.Clone(g.indexToNodes),
+		adjMatrix:    make([][]W, len(g.adjMatrix)),
+		hasEdge:      make([][]bool, len(g.hasEdge)),
+		attrs:        g.attrs.clone(),
+	}
+	for node, nbrs := range g.adjList {
+		c.adjList[node] = maps.Clone(nbrs)
+	}
+	for i, row := range g.adjMatrix {
+		c.adjMatrix[i] = slices.Clone(row)
+	}
+	for i, row := range g.hasEdge {
+		c.hasEdge[i] = slices.Clone(row)
+	}
+	return c
+}
+
+func (g *WeightedGraph[T, W]) Snapshot() *WeightedGraph[T, W] {
+	g.shared = true
+	s := *g
+	s.log = nil
+	return &s
+}
+
+func (g *WeightedGraph[T, W]) unshare() {
+	if g.shared {
+		log := g.log
+		*g = *g.Clone()
+		g.log = log
+	}
+}
@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// graphState captures what undo must restore: nodes in Nodes() order for
+// matrices, sorted otherwise, and the edge set.
+func graphState(g *Graph[string]) ([]string, [][2]string) {
+	nodes := g.Nodes()
+	if g.Representation() != AdjacencyMatrix {
+		slices.Sort(nodes)
+	}
+	edges := g.Edges()
+	for i, e := range edges {
+		if !g.IsDirected() && e[1] < e[0] {
+			edges[i] = [2]string{e[1], e[0]}
+		}
+	}
+	slices.SortFunc(edges, func(a, b [2]string) int { return slices.Compare(a[:], b[:]) })
+	return nodes, edges
+}
+
+func sameState(t *testing.T, label string, g *Graph[string], nodes []string, edges [][2]string) {
+	t.Helper()
+	gotNodes, gotEdges := graphState(g)
+	if !slices.Equal(gotNodes, nodes) || !slices.Equal(gotEdges, edges) {
+		t.Errorf("%s: got %v %v, want %v %v", label, gotNodes, gotEdges, nodes, edges)
+	}
+}
+
+func TestSnapshotIsolation(t *testing.T) {
+	mutations := []struct {
+		name   string
+		mutate func(g *Graph[string])
+	}{
+		{"AddNode", func(g *Graph[string]) { g.AddNode("z") }},
+		{"AddEdge", func(g *Graph[string]) { g.AddEdge("c", "a") }},
+		{"RemoveEdge", func(g *Graph[string]) { g.RemoveEdge("a", "b") }},
+		{"RemoveNode", func(g *Graph[string]) { g.RemoveNode("b") }},
+		{"storage AddEdge", func(g *Graph[string]) {
+			if g.Representation() == AdjacencyMatrix {
+				g.AddEdgeAdjMatrix("d", "d")
+			} else {
+				g.AddEdgeAdjList("d", "d")
+			}
+		}},
+		{"storage RemoveNode", func(g *Graph[string]) {
+			if g.Representation() == AdjacencyMatrix {
+				g.RemoveNodeAdjMatrix("a")
+			} else {
+				g.RemoveNodeAdjList("a")
+			}
+		}},
+		{"ConvertTo", func(g *Graph[string]) {
+			if g.Representation() == AdjacencyMatrix {
+				g.ConvertTo(AdjacencyList)
+			} else {
+				g.ConvertTo(AdjacencyMatrix)
+			}
+			g.AddEdge("d", "a")
+		}},
+	}
+	for _, repType := range representations {
+		for _, m := range mutations {
+			for _, side := range []string{"original", "snapshot"} {
+				label := fmt.Sprintf("%v/%s/%s", repType, m.name, side)
+				g := buildGraph(Directed, repType, [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}})
+				SetNodeAttr(g, "a", "k", 1)
+				nodes, edges := graphState(g)
+				s := g.Snapshot()
+				changed, kept := g, s
+				if side == "snapshot" {
+					changed, kept = s, g
+				}
+				m.mutate(changed)
+				SetNodeAttr(changed, "c", "k", 2)
+				sameState(t, label, kept, nodes, edges)
+				if _, ok := NodeAttr[int](kept, "c", "k"); ok {
+					t.Errorf("%s: attribute change leaked", label)
+				}
+				if v, _ := NodeAttr[int](kept, "a", "k"); v != 1 {
+					t.Errorf("%s: attribute lost, got %v", label, v)
+				}
+				if v, _ := NodeAttr[int](changed, "c", "k"); v != 2 {
+					t.Errorf("%s: attribute change did not apply", label)
+				}
+			}
+		}
+	}
+}
+
+func TestSnapshotChain(t *testing.T) {
+	for _, repType := range representations {
+		g := buildGraph(Undirected, repType, [][2]string{{"a", "b"}})
+		s1 := g.Snapshot()
+		g.AddEdge("b", "c")
+		s2 := g.Snapshot()
+		s3 := s2.Snapshot()
+		g.RemoveNode("a")
+		s3.AddNode("x")
+
+		sameState(t, "s1", s1, []string{"a", "b"}, [][2]string{{"a", "b"}})
+		sameState(t, "s2", s2, []string{"a", "b", "c"}, [][2]string{{"a", "b"}, {"b", "c"}})
+		sameState(t, "s3", s3, []string{"a", "b", "c", "x"}, [][2]string{{"a", "b"}, {"b", "c"}})
+		sameState(t, "g", g, []string{"b", "c"}, [][2]string{{"b", "c"}})
+	}
+}
+
+func TestSnapshotHistory(t *testing.T) {
+	g := NewGraph[string](Directed, AdjacencyList)
+	g.EnableUndo(0)
+	g.AddEdge("a", "b")
+	s := g.Snapshot()
+	if s.CanUndo() {
+		t.Errorf("snapshot inherited the undo history")
+	}
+	s.AddNode("x")
+	if !g.Undo() || g.HasEdge("a", "b") || !s.HasEdge("a", "b") {
+		t.Errorf("undo on the original: g %v, snapshot %v", g.Edges(), s.Edges())
+	}
+}
+
+func TestWeightedSnapshotAndClone(t *testing.T) {
+	for _, repType := range representations {
+		g := buildWeighted(Undirected, repType, []weightedEdgeCase{{"a", "b", 1}, {"b", "c", 2}})
+		s, c := g.Snapshot(), g.Clone()
+		g.AddEdge("a", "b", 9)
+		g.RemoveNode("c")
+		for name, view := range map[string]*WeightedGraph[string, int]{"snapshot": s, "clone": c} {
+			if w, _ := view.Weight("b", "a"); w != 1 || !view.HasEdge("c", "b") {
+				t.Errorf("%v/%s: sees later writes: %v", repType, name, view.Edges())
+			}
+		}
+		s.AddEdge("c", "d", 4)
+		if g.HasNode("d") || c.HasNode("d") {
+			t.Errorf("%v: snapshot write leaked", repType)
+		}
+		if w, _ := g.Weight("a", "b"); w != 9 {
+			t.Errorf("%v: original lost its write", repType)
+		}
+	}
+}
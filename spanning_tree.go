@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"container/heap"
+	"sort"
+)
+
+func (g *WeightedGraph[T, W]) Kruskal() (*WeightedGraph[T, W], W) {
+	return kruskal[T, W](g, g.repType)
+}
+
+func (g *WeightedGraph[T, W]) Prim() (*WeightedGraph[T, W], W) {
+	return prim[T, W](g, g.repType)
+}
+
+func (g *WeightedGraph[T, W]) Boruvka() (*WeightedGraph[T, W], W) {
+	return boruvka[T, W](g, g.repType)
+}
+
+// Kruskal returns a minimum spanning forest of the graph and its total
+// weight. The forest has one tree per connected component. The method form
+// keeps the graph's representation, otherwise the forest is an AdjacencyList
+// graph.
+//
+// Kruskal, Prim and Boruvka all treat a directed graph as undirected: an edge
+// from u to v can join u and v whichever way it points, so the three return
+// forests of the same weight for the same input.
+func Kruskal[T comparable, W Number](g WeightedTraversable[T, W]) (*WeightedGraph[T, W], W) {
+	return kruskal[T, W](g, AdjacencyList)
+}
+
+// Prim returns a minimum spanning forest of the graph and its total weight,
+// growing one tree per connected component with a binary heap. It only scans
+// neighbours, which suits dense AdjacencyMatrix graphs. Directed graphs are
+// treated as undirected, as in Kruskal.
+func Prim[T comparable, W Number](g WeightedTraversable[T, W]) (*WeightedGraph[T, W], W) {
+	return prim[T, W](g, AdjacencyList)
+}
+
+// Boruvka returns a minimum spanning forest of the graph and its total
+// weight. Each round every component picks its cheapest outgoing edge, so it
+// finishes in O(log n) rounds over the edge list. Directed graphs are treated
+// as undirected, as in Kruskal.
+func Boruvka[T comparable, W Number](g WeightedTraversable[T, W]) (*WeightedGraph[T, W], W) {
+	return boruvka[T, W](g, AdjacencyList)
+}
+
+func kruskal[T comparable, W Number](g WeightedTraversable[T, W], repType RepresentationType) (*WeightedGraph[T, W], W) {
+	forest := emptyForest[T, W](g, repType)
+	edges := edgesOf(g)
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight < edges[j].Weight
+	})
+	uf := NewUnionFind[T]()
+	var total W
+	for _, e := range edges {
+		if uf.Union(e.Edge[0], e.Edge[1]) {
+			forest.AddEdge(e.Edge[0], e.Edge[1], e.Weight)
+			total += e.Weight
+		}
+	}
+	return forest, total
+}
+
+func prim[T comparable, W Number](g WeightedTraversable[T, W], repType RepresentationType) (*WeightedGraph[T, W], W) {
+	forest := emptyForest[T, W](g, repType)
+	inTree := map[T]struct{}{}
+	best := map[T]W{}
+	from := map[T]T{}
+	adj := undirectedArcs(g)
+	var total W
+	for _, root := range g.Nodes() {
+		if _, done := inTree[root]; done {
+			continue
+		}
+		best[root] = 0
+		pq := &priorityQueue[T, W]{{node: root, priority: 0}}
+		for pq.Len() > 0 {
+			curr := heap.Pop(pq).(pqItem[T, W])
+			if _, done := inTree[curr.node]; done || curr.priority != best[curr.node] {
+				continue
+			}
+			inTree[curr.node] = struct{}{}
+			if curr.node != root {
+				forest.AddEdge(from[curr.node], curr.node, curr.priority)
+				total += curr.priority
+			}
+			for _, e := range adj[curr.node] {
+				nbr, weight := e.Edge[1], e.Weight
+				if _, done := inTree[nbr]; done {
+					continue
+				}
+				if b, seen := best[nbr]; !seen || weight < b {
+					best[nbr] = weight
+					from[nbr] = curr.node
+					heap.Push(pq, pqItem[T, W]{node: nbr, priority: weight})
+				}
+			}
+		}
+	}
+	return forest, total
+}
+
+func boruvka[T comparable, W Number](g WeightedTraversable[T, W], repType RepresentationType) (*WeightedGraph[T, W], W) {
+	forest := emptyForest[T, W](g, repType)
+	edges := edgesOf(g)
+	uf := NewUnionFind[T]()
+	for _, node := range g.Nodes() {
+		uf.Add(node)
+	}
+	var total W
+	for {
+		// cheapest edge index per component, ties broken by index so that
+		// equal weights can never close a cycle
+		cheapest := map[T]int{}
+		for i, e := range edges {
+			a, b := uf.Find(e.Edge[0]), uf.Find(e.Edge[1])
+			if a == b {
+				continue
+			}
+			for _, root := range [2]T{a, b} {
+				if j, ok := cheapest[root]; !ok || e.Weight < edges[j].Weight || (e.Weight == edges[j].Weight && i < j) {
+					cheapest[root] = i
+				}
+			}
+		}
+		if len(cheapest) == 0 {
+			break
+		}
+		for _, i := range cheapest {
+			e := edges[i]
+			if uf.Union(e.Edge[0], e.Edge[1]) {
+				forest.AddEdge(e.Edge[0], e.Edge[1], e.Weight)
+				total += e.Weight
+			}
+		}
+	}
+	return forest, total
+}
+
+// undirectedArcs returns the edges leaving each node when every edge of g can
+// be followed both ways. Directed edges are added in reverse as well.
+func undirectedArcs[T comparable, W Number](g WeightedTraversable[T, W]) map[T][]WeightedEdge[T, W] {
+	adj := map[T][]WeightedEdge[T, W]{}
+	for _, e := range arcs(g) {
+		adj[e.Edge[0]] = append(adj[e.Edge[0]], e)
+		if g.IsDirected() {
+			rev := WeightedEdge[T, W]{Edge: [2]T{e.Edge[1], e.Edge[0]}, Weight: e.Weight}
+			adj[e.Edge[1]] = append(adj[e.Edge[1]], rev)
+		}
+	}
+	return adj
+}
+
+// emptyForest returns an undirected graph with the same nodes as g but no
+// edges.
+func emptyForest[T comparable, W Number](g Traversable[T], repType RepresentationType) *WeightedGraph[T, W] {
+	forest := NewWeightedGraph[T, W](Undirected, repType)
+	for _, node := range g.Nodes() {
+		forest.AddNode(node)
+	}
+	return forest
+}
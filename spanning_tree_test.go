@@ -0,0 +1,102 @@
+package graph
+
+import "testing"
+
+func TestMinimumSpanningForest(t *testing.T) {
+	algorithms := map[string]func(*WeightedGraph[string, int]) (*WeightedGraph[string, int], int){
+		"Kruskal": (*WeightedGraph[string, int]).Kruskal,
+		"Prim":    (*WeightedGraph[string, int]).Prim,
+		"Boruvka": (*WeightedGraph[string, int]).Boruvka,
+	}
+	tests := []struct {
+		name      string
+		graphType GraphType
+		edges     []weightedEdgeCase
+		nodes     []string
+		total     int
+		treeEdges int
+	}{
+		{
+			name:      "square with diagonal",
+			graphType: Undirected,
+			edges:     []weightedEdgeCase{{"a", "b", 1}, {"b", "c", 2}, {"c", "d", 3}, {"d", "a", 4}, {"a", "c", 5}},
+			total:     6,
+			treeEdges: 3,
+		},
+		{
+			name:      "disconnected",
+			graphType: Undirected,
+			edges:     []weightedEdgeCase{{"a", "b", 2}, {"b", "c", 1}, {"a", "c", 7}, {"x", "y", 4}},
+			nodes:     []string{"lonely"},
+			total:     7,
+			treeEdges: 3,
+		},
+		{
+			name:      "directed edges followed both ways",
+			graphType: Directed,
+			edges:     []weightedEdgeCase{{"b", "a", 1}, {"c", "b", 1}, {"a", "c", 10}, {"d", "c", 2}},
+			total:     4,
+			treeEdges: 3,
+		},
+		{
+			name:      "negative weights",
+			graphType: Undirected,
+			edges:     []weightedEdgeCase{{"a", "b", -3}, {"b", "c", 2}, {"a", "c", -1}},
+			total:     -4,
+			treeEdges: 2,
+		},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			for name, mst := range algorithms {
+				g := buildWeighted(tt.graphType, repType, tt.edges)
+				for _, node := range tt.nodes {
+					g.AddNode(node)
+				}
+				forest, total := mst(g)
+				if total != tt.total {
+					t.Errorf("%s/%s/%v: total = %d, want %d", tt.name, name, repType, total, tt.total)
+				}
+				if got := len(forest.Edges()); got != tt.treeEdges {
+					t.Errorf("%s/%s/%v: %d edges, want %d", tt.name, name, repType, got, tt.treeEdges)
+				}
+				if got, want := len(forest.Nodes()), len(g.Nodes()); got != want {
+					t.Errorf("%s/%s/%v: %d nodes, want %d", tt.name, name, repType, got, want)
+				}
+				if forest.IsDirected() {
+					t.Errorf("%s/%s/%v: forest is directed", tt.name, name, repType)
+				}
+			}
+		}
+	}
+}
+
+func TestUnionFind(t *testing.T) {
+	uf := NewUnionFind[int]()
+	for i := 0; i < 6; i++ {
+		uf.Add(i)
+	}
+	steps := []struct {
+		a, b   int
+		merged bool
+		sets   int
+	}{
+		{0, 1, true, 5},
+		{2, 3, true, 4},
+		{1, 0, false, 4},
+		{1, 3, true, 3},
+		{0, 2, false, 3},
+		{4, 5, true, 2},
+	}
+	for _, s := range steps {
+		if got := uf.Union(s.a, s.b); got != s.merged {
+			t.Errorf("Union(%d, %d) = %v, want %v", s.a, s.b, got, s.merged)
+		}
+		if got := uf.Sets(); got != s.sets {
+			t.Errorf("after Union(%d, %d): Sets() = %d, want %d", s.a, s.b, got, s.sets)
+		}
+	}
+	if !uf.Connected(0, 3) || uf.Connected(0, 4) {
+		t.Errorf("Connected gives wrong answer")
+	}
+}
@@ -0,0 +1,213 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// CycleError is returned by the topological sorts when the graph is not a
+// DAG. Cycle lists the nodes of one cycle in edge order; the last node has an
+// edge back to the first. It matches ErrCycle under errors.Is.
+type CycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *CycleError[T]) Error() string {
+	return fmt.Sprintf("graph: cycle %v", e.Cycle)
+}
+
+func (e *CycleError[T]) Unwrap() error {
+	return ErrCycle
+}
+
+func (g *Graph[T]) TopologicalSort() ([]T, error) {
+	return TopologicalSort[T](g)
+}
+
+func (g *Graph[T]) TopologicalSortKahn(less func(a, b T) bool) ([]T, error) {
+	return TopologicalSortKahn[T](g, less)
+}
+
+func (g *Graph[T]) AllTopologicalOrders(yield func(order []T) bool) {
+	AllTopologicalOrders[T](g, yield)
+}
+
+func (g *WeightedGraph[T, W]) TopologicalSort() ([]T, error) {
+	return TopologicalSort[T](g)
+}
+
+func (g *WeightedGraph[T, W]) TopologicalSortKahn(less func(a, b T) bool) ([]T, error) {
+	return TopologicalSortKahn[T](g, less)
+}
+
+func (g *WeightedGraph[T, W]) AllTopologicalOrders(yield func(order []T) bool) {
+	AllTopologicalOrders[T](g, yield)
+}
+
+// TopologicalSort orders the nodes so that every edge points forward, using
+// a directed DepthFirstSearch. If the graph has a cycle it returns a
+// *CycleError.
+func TopologicalSort[T comparable](g Traversable[T]) ([]T, error) {
+	return dfsTopologicalSort(g.Nodes(), g.Neighbours)
+}
+
+// TopologicalSortKahn orders the nodes with Kahn's algorithm. Whenever
+// several nodes are ready, the smallest according to less is emitted first,
+// so the order depends only on the graph and less, never on map iteration
+// order. less is required: a nil less returns an error wrapping
+// ErrInvalidArgument. If the graph has a cycle it returns a *CycleError.
+func TopologicalSortKahn[T comparable](g Traversable[T], less func(a, b T) bool) ([]T, error) {
+	if less == nil {
+		return nil, fmt.Errorf("%w: TopologicalSortKahn needs a less function", ErrInvalidArgument)
+	}
+	return kahnTopologicalSort(g.Nodes(), g.Neighbours, less)
+}
+
+// AllTopologicalOrders calls yield with every topological order of the graph
+// until yield returns false. The number of orders grows factorially, so this
+// is only meant for small DAGs. Nothing is yielded if the graph has a cycle.
+// The slice passed to yield is reused between calls.
+func AllTopologicalOrders[T comparable](g Traversable[T], yield func(order []T) bool) {
+	allTopologicalOrders(g.Nodes(), g.Neighbours, yield)
+}
+
+// dfsTopologicalSort runs a directed depth-first search that keeps the
+// current path. A back edge closes a cycle; otherwise the reverse finishing
+// order is a topological order.
+func dfsTopologicalSort[T comparable](nodes []T, neighbours func(T) []T) ([]T, error) {
+	path := []T{}
+	position := map[T]int{} // node -> index in path
+	order := make([]T, 0, len(nodes))
+	var cycle []T
+	depthFirstSearch(nodes, neighbours, true, VisitorFuncs[T]{
+		OnDiscoverNode: func(node T) VisitAction {
+			position[node] = len(path)
+			path = append(path, node)
+			return Continue
+		},
+		OnFinishNode: func(node T) VisitAction {
+			path = path[:len(path)-1]
+			order = append(order, node)
+			return Continue
+		},
+		OnBackEdge: func(from T, to T) VisitAction {
+			cycle = append([]T{}, path[position[to]:]...)
+			return Stop
+		},
+	})
+	if cycle != nil {
+		return nil, &CycleError[T]{Cycle: cycle}
+	}
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+	return order, nil
+}
+
+func kahnTopologicalSort[T comparable](nodes []T, neighbours func(T) []T, less func(a, b T) bool) ([]T, error) {
+	inDegree := make(map[T]int, len(nodes))
+	adj := make(map[T][]T, len(nodes))
+	for _, node := range nodes {
+		adj[node] = neighbours(node)
+	}
+	for _, node := range nodes {
+		for _, nbr := range adj[node] {
+			inDegree[nbr]++
+		}
+	}
+	ready := &nodeHeap[T]{less: less}
+	for _, node := range nodes {
+		if inDegree[node] == 0 {
+			ready.nodes = append(ready.nodes, node)
+		}
+	}
+	heap.Init(ready)
+	order := make([]T, 0, len(nodes))
+	for ready.Len() > 0 {
+		curr := heap.Pop(ready).(T)
+		order = append(order, curr)
+		for _, nbr := range adj[curr] {
+			inDegree[nbr]--
+			if inDegree[nbr] == 0 {
+				heap.Push(ready, nbr)
+			}
+		}
+	}
+	if len(order) < len(nodes) {
+		_, err := dfsTopologicalSort(nodes, neighbours)
+		return nil, err
+	}
+	return order, nil
+}
+
+func allTopologicalOrders[T comparable](nodes []T, neighbours func(T) []T, yield func(order []T) bool) {
+	inDegree := make(map[T]int, len(nodes))
+	adj := make(map[T][]T, len(nodes))
+	for _, node := range nodes {
+		adj[node] = neighbours(node)
+	}
+	for _, node := range nodes {
+		for _, nbr := range adj[node] {
+			inDegree[nbr]++
+		}
+	}
+	used := make(map[T]struct{}, len(nodes))
+	order := make([]T, 0, len(nodes))
+
+	var backtrack func() bool
+	backtrack = func() bool {
+		if len(order) == len(nodes) {
+			return yield(order)
+		}
+		for _, node := range nodes {
+			if _, done := used[node]; done || inDegree[node] != 0 {
+				continue
+			}
+			used[node] = struct{}{}
+			order = append(order, node)
+			for _, nbr := range adj[node] {
+				inDegree[nbr]--
+			}
+			more := backtrack()
+			for _, nbr := range adj[node] {
+				inDegree[nbr]++
+			}
+			order = order[:len(order)-1]
+			delete(used, node)
+			if !more {
+				return false
+			}
+		}
+		return true
+	}
+	backtrack()
+}
+
+// nodeHeap is a min-heap of nodes ordered by a caller supplied comparator.
+type nodeHeap[T comparable] struct {
+	nodes []T
+	less  func(a, b T) bool
+}
+
+func (h *nodeHeap[T]) Len() int {
+	return len(h.nodes)
+}
+
+func (h *nodeHeap[T]) Less(i, j int) bool {
+	return h.less(h.nodes[i], h.nodes[j])
+}
+
+func (h *nodeHeap[T]) Swap(i, j int) {
+	h.nodes[i], h.nodes[j] = h.nodes[j], h.nodes[i]
+}
+
+func (h *nodeHeap[T]) Push(x any) {
+	h.nodes = append(h.nodes, x.(T))
+}
+
+func (h *nodeHeap[T]) Pop() any {
+	n := len(h.nodes)
+	node := h.nodes[n-1]
+	h.nodes = h.nodes[:n-1]
+	return node
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// checkTopologicalOrder fails t unless order lists every node of g once with
+// every edge pointing forward.
+func checkTopologicalOrder(t *testing.T, label string, g *Graph[string], order []string) {
+	t.Helper()
+	if len(order) != len(g.Nodes()) {
+		t.Fatalf("%s: order %v misses nodes of %v", label, order, g.Nodes())
+	}
+	for _, e := range g.Edges() {
+		if slices.Index(order, e[0]) > slices.Index(order, e[1]) {
+			t.Errorf("%s: edge %s -> %s points backwards in %v", label, e[0], e[1], order)
+		}
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	edges := [][2]string{{"shirt", "tie"}, {"tie", "jacket"}, {"trousers", "shoes"}, {"trousers", "belt"}, {"belt", "jacket"}, {"socks", "shoes"}}
+	for _, repType := range representations {
+		g := buildGraph(Directed, repType, edges)
+		g.AddNode("watch")
+		order, err := g.TopologicalSort()
+		if err != nil {
+			t.Fatalf("%v: %v", repType, err)
+		}
+		checkTopologicalOrder(t, fmt.Sprintf("%v/DFS", repType), g, order)
+
+		order, err = g.TopologicalSortKahn(func(a, b string) bool { return a < b })
+		if err != nil {
+			t.Fatalf("%v: %v", repType, err)
+		}
+		checkTopologicalOrder(t, fmt.Sprintf("%v/Kahn", repType), g, order)
+	}
+}
+
+func TestTopologicalSortKahnLess(t *testing.T) {
+	g := buildGraph(Directed, AdjacencyList, [][2]string{{"c", "a"}, {"d", "b"}, {"b", "a"}})
+	g.AddNode("e")
+	order, err := g.TopologicalSortKahn(func(a, b string) bool { return a < b })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"c", "d", "b", "a", "e"}; !slices.Equal(order, want) {
+		t.Errorf("got %v, want %v", order, want)
+	}
+	if order, err := g.TopologicalSortKahn(nil); order != nil || !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("nil less: got %v, %v, want ErrInvalidArgument", order, err)
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	edges := [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "b"}, {"d", "e"}}
+	sorts := map[string]func(*Graph[string]) ([]string, error){
+		"DFS": (*Graph[string]).TopologicalSort,
+		"Kahn": func(g *Graph[string]) ([]string, error) {
+			return g.TopologicalSortKahn(func(a, b string) bool { return a < b })
+		},
+	}
+	for _, repType := range representations {
+		g := buildGraph(Directed, repType, edges)
+		for name, sort := range sorts {
+			order, err := sort(g)
+			var cycleErr *CycleError[string]
+			if order != nil || !errors.As(err, &cycleErr) || !errors.Is(err, ErrCycle) {
+				t.Fatalf("%v/%s: got %v, %v, want a CycleError", repType, name, order, err)
+			}
+			cycle := cycleErr.Cycle
+			for i, from := range cycle {
+				if to := cycle[(i+1)%len(cycle)]; !g.HasEdge(from, to) {
+					t.Errorf("%v/%s: cycle %v uses missing edge %s -> %s", repType, name, cycle, from, to)
+				}
+			}
+		}
+	}
+}
+
+func TestAllTopologicalOrders(t *testing.T) {
+	tests := []struct {
+		name   string
+		edges  [][2]string
+		nodes  []string
+		orders []string
+	}{
+		{"diamond", [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}}, nil, []string{"abcd", "acbd"}},
+		{"independent", nil, []string{"x", "y", "z"}, []string{"xyz", "xzy", "yxz", "yzx", "zxy", "zyx"}},
+		{"cycle", [][2]string{{"a", "b"}, {"b", "a"}}, nil, nil},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := buildGraph(Directed, repType, tt.edges)
+			for _, node := range tt.nodes {
+				g.AddNode(node)
+			}
+			var got []string
+			g.AllTopologicalOrders(func(order []string) bool {
+				got = append(got, strings.Join(order, ""))
+				return true
+			})
+			slices.Sort(got)
+			if !slices.Equal(got, tt.orders) {
+				t.Errorf("%s/%v: got %v, want %v", tt.name, repType, got, tt.orders)
+			}
+		}
+	}
+
+	g := buildGraph(Directed, AdjacencyList, nil)
+	g.AddNode("x")
+	g.AddNode("y")
+	g.AddNode("z")
+	calls := 0
+	g.AllTopologicalOrders(func([]string) bool {
+		calls++
+		return calls < 2
+	})
+	if calls != 2 {
+		t.Errorf("yield called %d times after returning false, want 2", calls)
+	}
+}
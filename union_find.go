@@ -0,0 +1,70 @@
+package graph
+
+// UnionFind is a disjoint-set forest with union by rank and path
+// compression. Elements are added implicitly the first time they are seen.
+type UnionFind[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	sets   int
+}
+
+// NewUnionFind returns an empty UnionFind.
+func NewUnionFind[T comparable]() *UnionFind[T] {
+	return &UnionFind[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+	}
+}
+
+// Add makes x a singleton set if it has not been seen before.
+func (uf *UnionFind[T]) Add(x T) {
+	if _, exists := uf.parent[x]; exists {
+		return
+	}
+	uf.parent[x] = x
+	uf.rank[x] = 0
+	uf.sets++
+}
+
+// Find returns the representative of the set containing x.
+func (uf *UnionFind[T]) Find(x T) T {
+	uf.Add(x)
+	root := x
+	for uf.parent[root] != root {
+		root = uf.parent[root]
+	}
+	for x != root {
+		next := uf.parent[x]
+		uf.parent[x] = root
+		x = next
+	}
+	return root
+}
+
+// Union merges the sets containing a and b. It returns false if they were
+// already in the same set.
+func (uf *UnionFind[T]) Union(a T, b T) bool {
+	rootA, rootB := uf.Find(a), uf.Find(b)
+	if rootA == rootB {
+		return false
+	}
+	if uf.rank[rootA] < uf.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	uf.parent[rootB] = rootA
+	if uf.rank[rootA] == uf.rank[rootB] {
+		uf.rank[rootA]++
+	}
+	uf.sets--
+	return true
+}
+
+// Connected reports whether a and b are in the same set.
+func (uf *UnionFind[T]) Connected(a T, b T) bool {
+	return uf.Find(a) == uf.Find(b)
+}
+
+// Sets returns the number of disjoint sets.
+func (uf *UnionFind[T]) Sets() int {
+	return uf.sets
+}
@@ -0,0 +1,212 @@
+package graph
+
+// VisitAction tells DepthFirstSearch how to go on after a Visitor hook.
+type VisitAction int
+
+const (
+	// Continue carries on with the search.
+	Continue VisitAction = iota
+	// Prune skips the part of the search below the current event: returned
+	// from DiscoverNode the node's edges are not examined, returned from
+	// TreeEdge the edge is not followed and its target stays undiscovered.
+	// Other hooks treat it as Continue.
+	Prune
+	// Stop ends the search at once. No further hooks are called, not even
+	// FinishNode for the nodes still open.
+	Stop
+)
+
+// Visitor receives the events of DepthFirstSearch. Every edge examined is
+// reported to exactly one of the edge hooks:
+//
+//   - TreeEdge: to is undiscovered and is entered next
+//   - BackEdge: to is an ancestor of from (or from itself), closing a cycle
+//   - ForwardEdge: to is an already finished descendant of from
+//   - CrossEdge: to is finished and in another subtree
+//
+// In undirected graphs every edge is reported once: the edge back to the DFS
+// parent is skipped, and every non-tree edge is a BackEdge from the
+// descendant to the ancestor.
+type Visitor[T comparable] interface {
+	DiscoverNode(node T) VisitAction
+	FinishNode(node T) VisitAction
+	TreeEdge(from T, to T) VisitAction
+	BackEdge(from T, to T) VisitAction
+	ForwardEdge(from T, to T) VisitAction
+	CrossEdge(from T, to T) VisitAction
+}
+
+// VisitorFuncs is a Visitor built from optional functions; a nil function
+// returns Continue. Its zero value visits without doing anything, so it can
+// also be embedded in a type that implements only some of the hooks.
+type VisitorFuncs[T comparable] struct {
+	OnDiscoverNode func(node T) VisitAction
+	OnFinishNode   func(node T) VisitAction
+	OnTreeEdge     func(from T, to T) VisitAction
+	OnBackEdge     func(from T, to T) VisitAction
+	OnForwardEdge  func(from T, to T) VisitAction
+	OnCrossEdge    func(from T, to T) VisitAction
+}
+
+var _ Visitor[int] = VisitorFuncs[int]{}
+
+func (v VisitorFuncs[T]) DiscoverNode(node T) VisitAction {
+	if v.OnDiscoverNode == nil {
+		return Continue
+	}
+	return v.OnDiscoverNode(node)
+}
+
+func (v VisitorFuncs[T]) FinishNode(node T) VisitAction {
+	if v.OnFinishNode == nil {
+		return Continue
+	}
+	return v.OnFinishNode(node)
+}
+
+func (v VisitorFuncs[T]) TreeEdge(from T, to T) VisitAction {
+	if v.OnTreeEdge == nil {
+		return Continue
+	}
+	return v.OnTreeEdge(from, to)
+}
+
+func (v VisitorFuncs[T]) BackEdge(from T, to T) VisitAction {
+	if v.OnBackEdge == nil {
+		return Continue
+	}
+	return v.OnBackEdge(from, to)
+}
+
+func (v VisitorFuncs[T]) ForwardEdge(from T, to T) VisitAction {
+	if v.OnForwardEdge == nil {
+		return Continue
+	}
+	return v.OnForwardEdge(from, to)
+}
+
+func (v VisitorFuncs[T]) CrossEdge(from T, to T) VisitAction {
+	if v.OnCrossEdge == nil {
+		return Continue
+	}
+	return v.OnCrossEdge(from, to)
+}
+
+func (g *Graph[T]) DepthFirstSearch(visitor Visitor[T], roots ...T) bool {
+	return DepthFirstSearch[T](g, visitor, roots...)
+}
+
+func (g *Graph[T]) HasCycle() bool {
+	return HasCycle[T](g)
+}
+
+func (g *WeightedGraph[T, W]) DepthFirstSearch(visitor Visitor[T], roots ...T) bool {
+	return DepthFirstSearch[T](g, visitor, roots...)
+}
+
+func (g *WeightedGraph[T, W]) HasCycle() bool {
+	return HasCycle[T](g)
+}
+
+// DepthFirstSearch runs an iterative depth-first search from each root in
+// turn, skipping roots already reached, and reports its events to visitor.
+// Without roots it starts from every node in g.Nodes() order, covering the
+// whole graph. It reports whether a hook stopped the search. Parallel edges of
+// a Multigraph are reported one by one.
+func DepthFirstSearch[T comparable](g Traversable[T], visitor Visitor[T], roots ...T) bool {
+	if len(roots) == 0 {
+		roots = g.Nodes()
+	}
+	return depthFirstSearch(roots, edgeNeighboursOf(g), g.IsDirected(), visitor)
+}
+
+// HasCycle reports whether the graph has a cycle, following edge directions
+// in directed graphs. A self-loop is a cycle, and so are two parallel edges
+// of an undirected Multigraph.
+func HasCycle[T comparable](g Traversable[T]) bool {
+	return hasCycle(g.Nodes(), edgeNeighboursOf(g), g.IsDirected())
+}
+
+// hasCycle looks for a back edge, treating the edges as directed or not
+// whatever the graph is, as HasCycleDirected and HasCycleUndirected do.
+func hasCycle[T comparable](nodes []T, neighbours func(T) []T, directed bool) bool {
+	return depthFirstSearch(nodes, neighbours, directed, VisitorFuncs[T]{
+		OnBackEdge: func(from T, to T) VisitAction { return Stop },
+	})
+}
+
+// depthFirstSearch is DepthFirstSearch over a neighbour function. Undirected
+// graphs are searched as described on Visitor.
+func depthFirstSearch[T comparable](roots []T, neighbours func(T) []T, directed bool, visitor Visitor[T]) bool {
+	type frame struct {
+		node          T
+		parent        T
+		isRoot        bool
+		skippedParent bool
+		nbrs          []T
+		next          int
+	}
+	disc := map[T]int{}
+	finished := map[T]struct{}{}
+	time := 0
+
+	for _, root := range roots {
+		if _, seen := disc[root]; seen {
+			continue
+		}
+		callStack := []*frame{}
+		// discover enters node and reports whether the search goes on.
+		discover := func(f *frame) bool {
+			disc[f.node] = time
+			time++
+			callStack = append(callStack, f)
+			switch visitor.DiscoverNode(f.node) {
+			case Stop:
+				return false
+			case Continue:
+				f.nbrs = neighbours(f.node)
+			}
+			return true
+		}
+		if !discover(&frame{node: root, isRoot: true}) {
+			return true
+		}
+		for len(callStack) > 0 {
+			top := callStack[len(callStack)-1]
+			if top.next < len(top.nbrs) {
+				nbr := top.nbrs[top.next]
+				top.next++
+				_, seen := disc[nbr]
+				_, done := finished[nbr]
+				var action VisitAction
+				switch {
+				case !seen:
+					action = visitor.TreeEdge(top.node, nbr)
+					if action == Continue && !discover(&frame{node: nbr, parent: top.node}) {
+						return true
+					}
+				case !directed && done:
+					// the descendant nbr already reported this edge
+				case !directed && !top.isRoot && !top.skippedParent && nbr == top.parent:
+					top.skippedParent = true
+				case !done:
+					action = visitor.BackEdge(top.node, nbr)
+				case disc[top.node] < disc[nbr]:
+					action = visitor.ForwardEdge(top.node, nbr)
+				default:
+					action = visitor.CrossEdge(top.node, nbr)
+				}
+				if action == Stop {
+					return true
+				}
+				continue
+			}
+			callStack = callStack[:len(callStack)-1]
+			finished[top.node] = struct{}{}
+			if visitor.FinishNode(top.node) == Stop {
+				return true
+			}
+		}
+	}
+	return false
+}
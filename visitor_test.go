@@ -0,0 +1,183 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// eventLog records DepthFirstSearch events as strings such as "tree 1 2".
+type eventLog struct {
+	VisitorFuncs[int]
+	events []string
+}
+
+func newEventLog() *eventLog {
+	l := &eventLog{}
+	edge := func(kind string) func(int, int) VisitAction {
+		return func(from int, to int) VisitAction {
+			l.events = append(l.events, fmt.Sprintf("%s %d %d", kind, from, to))
+			return Continue
+		}
+	}
+	l.OnTreeEdge = edge("tree")
+	l.OnBackEdge = edge("back")
+	l.OnForwardEdge = edge("forward")
+	l.OnCrossEdge = edge("cross")
+	return l
+}
+
+func (l *eventLog) count(kind string) int {
+	n := 0
+	for _, e := range l.events {
+		if strings.HasPrefix(e, kind+" ") {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDepthFirstSearchEdgeClassification(t *testing.T) {
+	tests := []struct {
+		name                       string
+		graphType                  GraphType
+		edges                      [][2]int
+		tree, back, forward, cross int
+	}{
+		{"directed acyclic", Directed, [][2]int{{1, 2}, {1, 3}, {2, 3}}, 2, 0, -1, -1},
+		{"directed cycle", Directed, [][2]int{{1, 2}, {2, 3}, {3, 1}}, 2, 1, 0, 0},
+		{"directed self-loop", Directed, [][2]int{{1, 1}}, 0, 1, 0, 0},
+		{"undirected triangle", Undirected, [][2]int{{1, 2}, {2, 3}, {3, 1}}, 2, 1, 0, 0},
+		{"undirected path", Undirected, [][2]int{{1, 2}, {2, 3}}, 2, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := NewGraph[int](tt.graphType, repType)
+			for _, e := range tt.edges {
+				g.AddEdge(e[0], e[1])
+			}
+			l := newEventLog()
+			if g.DepthFirstSearch(l, 1) {
+				t.Errorf("%s/%v: search reported a stop", tt.name, repType)
+			}
+			for kind, want := range map[string]int{"tree": tt.tree, "back": tt.back, "forward": tt.forward, "cross": tt.cross} {
+				if want >= 0 && l.count(kind) != want {
+					t.Errorf("%s/%v: %d %s edges, want %d (%v)", tt.name, repType, l.count(kind), kind, want, l.events)
+				}
+			}
+			if got := l.count("forward") + l.count("cross") + l.count("tree") + l.count("back"); tt.graphType == Directed && got != len(tt.edges) {
+				t.Errorf("%s/%v: %d edges reported, want %d", tt.name, repType, got, len(tt.edges))
+			}
+		}
+	}
+}
+
+func TestDepthFirstSearchPruneAndStop(t *testing.T) {
+	g := NewGraph[int](Directed, AdjacencyList)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(1, 4)
+
+	discovered := []int{}
+	stopped := g.DepthFirstSearch(VisitorFuncs[int]{
+		OnDiscoverNode: func(node int) VisitAction {
+			discovered = append(discovered, node)
+			if node == 2 {
+				return Prune
+			}
+			return Continue
+		},
+	}, 1)
+	slices.Sort(discovered)
+	if stopped || !slices.Equal(discovered, []int{1, 2, 4}) {
+		t.Errorf("pruned search discovered %v, stopped %v", discovered, stopped)
+	}
+
+	finished := 0
+	stopped = g.DepthFirstSearch(VisitorFuncs[int]{
+		OnDiscoverNode: func(node int) VisitAction {
+			if node == 3 {
+				return Stop
+			}
+			return Continue
+		},
+		OnFinishNode: func(node int) VisitAction {
+			finished++
+			return Continue
+		},
+	}, 1, 2)
+	if !stopped || finished > 1 {
+		t.Errorf("stopped search: stopped %v, %d nodes finished", stopped, finished)
+	}
+}
+
+func TestHasCycle(t *testing.T) {
+	tests := []struct {
+		name                   string
+		graphType              GraphType
+		edges                  [][2]int
+		cycle, directed, undir bool
+	}{
+		{"directed dag", Directed, [][2]int{{1, 2}, {1, 3}, {2, 3}}, false, false, true},
+		{"directed chain", Directed, [][2]int{{1, 2}, {2, 3}}, false, false, false},
+		{"directed cycle", Directed, [][2]int{{1, 2}, {2, 3}, {3, 1}}, true, true, true},
+		{"directed two-cycle", Directed, [][2]int{{1, 2}, {2, 1}}, true, true, false},
+		{"directed self-loop", Directed, [][2]int{{1, 1}}, true, true, true},
+		{"undirected tree", Undirected, [][2]int{{1, 2}, {2, 3}, {2, 4}}, false, true, false},
+		{"undirected triangle", Undirected, [][2]int{{1, 2}, {2, 3}, {3, 1}}, true, true, true},
+		{"undirected forest and cycle", Undirected, [][2]int{{1, 2}, {5, 6}, {6, 7}, {7, 5}}, true, true, true},
+	}
+	for _, tt := range tests {
+		for _, repType := range representations {
+			g := NewWeightedGraph[int, int](tt.graphType, repType)
+			for _, e := range tt.edges {
+				g.AddEdge(e[0], e[1], 1)
+			}
+			if got := g.HasCycle(); got != tt.cycle {
+				t.Errorf("%s/%v: HasCycle = %v, want %v", tt.name, repType, got, tt.cycle)
+			}
+			if got := g.HasCycleDirected(); got != tt.directed {
+				t.Errorf("%s/%v: HasCycleDirected = %v, want %v", tt.name, repType, got, tt.directed)
+			}
+			if got := g.HasCycleUndirected(); got != tt.undir {
+				t.Errorf("%s/%v: HasCycleUndirected = %v, want %v", tt.name, repType, got, tt.undir)
+			}
+		}
+	}
+}
+
+func TestDeprecatedHasCycle(t *testing.T) {
+	tests := []struct {
+		name                     string
+		graphType                GraphType
+		edges                    [][2]int
+		directed, undir, undirAM bool
+	}{
+		{"directed triangle", Directed, [][2]int{{1, 2}, {2, 3}, {3, 1}}, true, true, false},
+		{"directed two-cycle", Directed, [][2]int{{1, 2}, {2, 1}}, true, false, false},
+		{"undirected self-loop", Undirected, [][2]int{{1, 1}}, true, false, true},
+		{"undirected tree", Undirected, [][2]int{{1, 2}, {2, 3}, {2, 4}}, true, false, false},
+		{"undirected triangle", Undirected, [][2]int{{1, 2}, {2, 3}, {3, 1}}, true, true, true},
+	}
+	for _, tt := range tests {
+		list := NewGraph[int](tt.graphType, AdjacencyList)
+		matrix := NewWeightedGraph[int, int](tt.graphType, AdjacencyMatrix)
+		for _, e := range tt.edges {
+			list.AddEdge(e[0], e[1])
+			matrix.AddEdge(e[0], e[1], 1)
+		}
+		if got := list.HasCycleDirectedAdjList(); got != tt.directed {
+			t.Errorf("%s: HasCycleDirectedAdjList = %v, want %v", tt.name, got, tt.directed)
+		}
+		if got := matrix.HasCycleDirectedAdjMatrix(); got != tt.directed {
+			t.Errorf("%s: HasCycleDirectedAdjMatrix = %v, want %v", tt.name, got, tt.directed)
+		}
+		if got := list.HasCycleUndirectedAdjList(); got != tt.undir {
+			t.Errorf("%s: HasCycleUndirectedAdjList = %v, want %v", tt.name, got, tt.undir)
+		}
+		if got := matrix.HasCycleUndirectedAdjMatrix(); got != tt.undirAM {
+			t.Errorf("%s: HasCycleUndirectedAdjMatrix = %v, want %v", tt.name, got, tt.undirAM)
+		}
+	}
+}
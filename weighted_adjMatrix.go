@@ -1,24 +1,29 @@
 package graph
 
-func (g *WeightedGraph[T]) AddNodeAdjMatrix(node T) {
+func (g *WeightedGraph[T, W]) AddNodeAdjMatrix(node T) {
+	g.unshare()
 	if _, exists := g.nodes[node]; exists {
 		return
 	}
+	g.recordAddNode(node)
 	g.nodes[node] = struct{}{}
 	g.indexToNodes = append(g.indexToNodes, node)
 	g.nodesToIndex[node] = len(g.indexToNodes) - 1
 	for i := 0; i < len(g.indexToNodes)-1; i++ {
-		g.adjMatrix[i] = append(g.adjMatrix[i], INF)
+		g.adjMatrix[i] = append(g.adjMatrix[i], 0)
+		g.hasEdge[i] = append(g.hasEdge[i], false)
 	}
 	n := len(g.indexToNodes)
-	newRow := make([]int, n)
-	g.adjMatrix = append(g.adjMatrix, newRow)
+	g.adjMatrix = append(g.adjMatrix, make([]W, n))
+	g.hasEdge = append(g.hasEdge, make([]bool, n))
 }
 
-func (g *WeightedGraph[T]) RemoveNodeAdjMatrix(node T) {
+func (g *WeightedGraph[T, W]) RemoveNodeAdjMatrix(node T) {
+	g.unshare()
 	if _, exists := g.nodes[node]; !exists {
 		return
 	}
+	g.recordRemoveNode(node)
 	length := len(g.indexToNodes)
 	delete(g.nodes, node)
 	index := g.nodesToIndex[node]
@@ -35,51 +40,67 @@ func (g *WeightedGraph[T]) RemoveNodeAdjMatrix(node T) {
 	for k := 0; k < length; k++ {
 		for i := index; i < length-1; i++ {
 			g.adjMatrix[k][i] = g.adjMatrix[k][i+1]
+			g.hasEdge[k][i] = g.hasEdge[k][i+1]
 		}
 		g.adjMatrix[k] = g.adjMatrix[k][:length-1]
+		g.hasEdge[k] = g.hasEdge[k][:length-1]
+	}
+	for i := index; i < length-1; i++ {
+		g.adjMatrix[i] = g.adjMatrix[i+1]
+		g.hasEdge[i] = g.hasEdge[i+1]
 	}
 	g.adjMatrix = g.adjMatrix[:length-1]
+	g.hasEdge = g.hasEdge[:length-1]
+	g.attrs.removeNode(node)
 
 }
 
-func (g *WeightedGraph[T]) AddEdgeAdjMatrix(from T, to T, weight int) {
+func (g *WeightedGraph[T, W]) AddEdgeAdjMatrix(from T, to T, weight W) {
+	g.unshare()
+	defer g.log.group()()
 	if _, ok := g.nodes[from]; !ok {
-		g.AddNode(from)
+		g.AddNodeAdjMatrix(from)
 	}
 	if _, ok := g.nodes[to]; !ok {
-		g.AddNode(to)
+		g.AddNodeAdjMatrix(to)
 	}
+	g.recordAddEdge(from, to, weight)
 	g.adjMatrix[g.nodesToIndex[from]][g.nodesToIndex[to]] = weight
+	g.hasEdge[g.nodesToIndex[from]][g.nodesToIndex[to]] = true
 	if g.graphType == Undirected {
 		g.adjMatrix[g.nodesToIndex[to]][g.nodesToIndex[from]] = weight
+		g.hasEdge[g.nodesToIndex[to]][g.nodesToIndex[from]] = true
 	}
 
 }
 
-func (g *WeightedGraph[T]) RemoveEdgeAdjMatrix(from T, to T) {
+func (g *WeightedGraph[T, W]) RemoveEdgeAdjMatrix(from T, to T) {
+	g.unshare()
 	if _, ok := g.nodes[from]; !ok {
 		return
 	}
 	if _, ok := g.nodes[to]; !ok {
 		return
 	}
-	g.adjMatrix[g.nodesToIndex[from]][g.nodesToIndex[to]] = INF
+	g.recordRemoveEdge(from, to)
+	g.hasEdge[g.nodesToIndex[from]][g.nodesToIndex[to]] = false
 	if g.graphType == Undirected {
-		g.adjMatrix[g.nodesToIndex[to]][g.nodesToIndex[from]] = INF
+		g.hasEdge[g.nodesToIndex[to]][g.nodesToIndex[from]] = false
 	}
+	g.attrs.removeEdge(from, to, g.IsDirected())
 }
-func (g *WeightedGraph[T]) HasEdgeAdjMatrix(from T, to T) bool {
+func (g *WeightedGraph[T, W]) HasEdgeAdjMatrix(from T, to T) bool {
 	if _, ok := g.nodes[from]; !ok {
 		return false
 	}
 	if _, ok := g.nodes[to]; !ok {
 		return false
 	}
-	if g.adjMatrix[g.nodesToIndex[from]][g.nodesToIndex[to]] != INF {
+	if g.hasEdge[g.nodesToIndex[from]][g.nodesToIndex[to]] {
 		if g.graphType == Directed {
 			return true
 		} else {
-			if g.adjMatrix[g.nodesToIndex[from]][g.nodesToIndex[to]] != INF {
+			if g.hasEdge[g.nodesToIndex[from]][g.nodesToIndex[to]] {
 				return true
 			}
 		}
@@ -87,16 +108,23 @@ func (g *WeightedGraph[T]) HasEdgeAdjMatrix(from T, to T) bool {
 	return false
 }
 
-func (g *WeightedGraph[T]) NeighboursAdjMatrix(node T) []T {
+func (g *WeightedGraph[T, W]) WeightAdjMatrix(from T, to T) (W, bool) {
+	if !g.HasEdgeAdjMatrix(from, to) {
+		return Infinity[W](), false
+	}
+	return g.adjMatrix[g.nodesToIndex[from]][g.nodesToIndex[to]], true
+}
+
+func (g *WeightedGraph[T, W]) NeighboursAdjMatrix(node T) []T {
 	if !g.HasNode(node) {
 		return make([]T, 0)
 	}
 	nodes := []T{}
 	matrixLength := len(g.nodes)
 	for i := 0; i < matrixLength; i++ {
-		if g.adjMatrix[g.nodesToIndex[node]][i] != INF {
+		if g.hasEdge[g.nodesToIndex[node]][i] {
 			if g.graphType == Undirected {
-				if g.adjMatrix[i][g.nodesToIndex[node]] != INF {
+				if g.hasEdge[i][g.nodesToIndex[node]] {
 					nodes = append(nodes, g.indexToNodes[i])
 				}
 			} else {
@@ -107,16 +135,16 @@ func (g *WeightedGraph[T]) NeighboursAdjMatrix(node T) []T {
 	return nodes
 }
 
-func (g *WeightedGraph[T]) EdgesAdjMatrix() []WeightedEdge[T] {
-	edges := make([]WeightedEdge[T], 0)
+func (g *WeightedGraph[T, W]) EdgesAdjMatrix() []WeightedEdge[T, W] {
+	edges := make([]WeightedEdge[T, W], 0)
 	for i := 0; i < len(g.nodesToIndex); i++ {
 		for k := 0; k < len(g.nodesToIndex); k++ {
-			if g.adjMatrix[i][k] != INF {
+			if g.hasEdge[i][k] {
 				if g.graphType == Directed {
-					edges = append(edges, WeightedEdge[T]{Edge: [2]T{g.indexToNodes[i], g.indexToNodes[k]}, Weight: g.adjMatrix[i][k]})
+					edges = append(edges, WeightedEdge[T, W]{Edge: [2]T{g.indexToNodes[i], g.indexToNodes[k]}, Weight: g.adjMatrix[i][k]})
 				} else {
-					if i < k {
-						edges = append(edges, WeightedEdge[T]{Edge: [2]T{g.indexToNodes[i], g.indexToNodes[k]}, Weight: g.adjMatrix[i][k]})
+					if i <= k {
+						edges = append(edges, WeightedEdge[T, W]{Edge: [2]T{g.indexToNodes[i], g.indexToNodes[k]}, Weight: g.adjMatrix[i][k]})
 					}
 				}
 
@@ -126,29 +154,29 @@ func (g *WeightedGraph[T]) EdgesAdjMatrix() []WeightedEdge[T] {
 	return edges
 }
 
-func (g *WeightedGraph[T]) OutDegreeAdjMatrix(node T) int {
+func (g *WeightedGraph[T, W]) OutDegreeAdjMatrix(node T) int {
 	count := 0
 	index := g.nodesToIndex[node]
 	for i := 0; i < len(g.indexToNodes); i++ {
-		if g.adjMatrix[index][i] != INF {
+		if g.hasEdge[index][i] {
 			count++
 		}
 	}
 	return count
 }
 
-func (g *WeightedGraph[T]) InDegreeAdjMatrix(node T) int {
+func (g *WeightedGraph[T, W]) InDegreeAdjMatrix(node T) int {
 	count := 0
 	index := g.nodesToIndex[node]
 	for i := 0; i < len(g.indexToNodes); i++ {
-		if g.adjMatrix[i][index] != INF {
+		if g.hasEdge[i][index] {
 			count++
 		}
 	}
 	return count
 }
 
-func (g *WeightedGraph[T]) BFSAdjMatrix(start T) []T {
+func (g *WeightedGraph[T, W]) BFSAdjMatrix(start T) []T {
 	visited := map[T]struct{}{}
 	queue := []T{start}
 	order := []T{start}
@@ -169,7 +197,7 @@ func (g *WeightedGraph[T]) BFSAdjMatrix(start T) []T {
 	return order
 }
 
-func (g *WeightedGraph[T]) DFSIterativeAdjMatrix(start T) []T {
+func (g *WeightedGraph[T, W]) DFSIterativeAdjMatrix(start T) []T {
 	order := []T{}
 	visited := map[T]struct{}{}
 	stack := []T{start}
@@ -195,7 +223,7 @@ func (g *WeightedGraph[T]) DFSIterativeAdjMatrix(start T) []T {
 	return order
 }
 
-func (g *WeightedGraph[T]) DFSRecursiveAdjMatrix(start T) []T {
+func (g *WeightedGraph[T, W]) DFSRecursiveAdjMatrix(start T) []T {
 	order := []T{}
 	visited := map[T]struct{}{}
 
@@ -216,10 +244,11 @@ func (g *WeightedGraph[T]) DFSRecursiveAdjMatrix(start T) []T {
 			}
 		}
 	}
+	dfs(start)
 	return order
 }
 
-func (g *WeightedGraph[T]) RecursiveDFSAllPathFindingAdjMatrix(source T, target T) [][]T {
+func (g *WeightedGraph[T, W]) RecursiveDFSAllPathFindingAdjMatrix(source T, target T) [][]T {
 	orders := [][]T{}
 	visited := make(map[T]struct{})
 
@@ -249,7 +278,7 @@ func (g *WeightedGraph[T]) RecursiveDFSAllPathFindingAdjMatrix(source T, target
 	return orders
 }
 
-func (g *WeightedGraph[T]) RecursiveDFSAnyPathFindingAdjMatrix(source T, target T) []T {
+func (g *WeightedGraph[T, W]) RecursiveDFSAnyPathFindingAdjMatrix(source T, target T) []T {
 	visited := make(map[T]struct{})
 	returnOrder := []T{}
 
@@ -286,7 +315,7 @@ func (g *WeightedGraph[T]) RecursiveDFSAnyPathFindingAdjMatrix(source T, target
 
 }
 
-func (g *WeightedGraph[T]) DFSIterativeAllPathFindingAdjMatrix(source T, target T) [][]T {
+func (g *WeightedGraph[T, W]) DFSIterativeAllPathFindingAdjMatrix(source T, target T) [][]T {
 	visiteds := []map[T]struct{}{map[T]struct{}{}}
 	stack := []T{source}
 	orders := [][]T{[]T{}}
@@ -310,6 +339,9 @@ func (g *WeightedGraph[T]) DFSIterativeAllPathFindingAdjMatrix(source T, target
 				order = append(order, curr)
 				for i := 0; i < len(g.indexToNodes); i++ {
 					nbr := g.indexToNodes[i]
+					if !g.HasEdge(curr, nbr) {
+						continue
+					}
 					if _, done := visited[nbr]; !done {
 						stack = append(stack, nbr)
 						clonedOrder := append([]T{}, order...)
@@ -332,7 +364,7 @@ func (g *WeightedGraph[T]) DFSIterativeAllPathFindingAdjMatrix(source T, target
 
 }
 
-func (g *WeightedGraph[T]) DFSIterativeAnyPathFindingAdjMatrix(source T, target T) []T {
+func (g *WeightedGraph[T, W]) DFSIterativeAnyPathFindingAdjMatrix(source T, target T) []T {
 	visiteds := []map[T]struct{}{map[T]struct{}{}}
 	stack := []T{source}
 	orders := [][]T{[]T{}}
@@ -355,6 +387,9 @@ func (g *WeightedGraph[T]) DFSIterativeAnyPathFindingAdjMatrix(source T, target
 				order = append(order, curr)
 				for i := 0; i < len(g.indexToNodes); i++ {
 					nbr := g.indexToNodes[i]
+					if !g.HasEdge(curr, nbr) {
+						continue
+					}
 					if _, done := visited[nbr]; !done {
 						stack = append(stack, nbr)
 						clonedOrder := append([]T{}, order...)
@@ -376,31 +411,29 @@ func (g *WeightedGraph[T]) DFSIterativeAnyPathFindingAdjMatrix(source T, target
 	return []T{}
 
 }
-func (g *WeightedGraph[T]) BFSShortestPathAdjMatrix(source T, target T) []T {
+func (g *WeightedGraph[T, W]) BFSShortestPathAdjMatrix(source T, target T) []T {
 	queue := []T{source}
 	visited := map[T]struct{}{}
 	parents := map[T]T{}
+	visited[source] = struct{}{}
 	for len(queue) > 0 {
 		curr := queue[0]
 		queue = queue[1:]
-		if _, ok := visited[curr]; !ok {
-			visited[curr] = struct{}{}
-			if curr == target {
-				path := []T{}
-				for node := target; ; node = parents[node] {
-					path = append(path, node)
-					if node == source {
-						return path
-					}
+		if curr == target {
+			path := []T{}
+			for node := target; ; node = parents[node] {
+				path = append([]T{node}, path...)
+				if node == source {
+					return path
 				}
-			} else {
-				for i := 0; i < len(g.indexToNodes); i++ {
-					if g.HasEdge(curr, g.indexToNodes[i]) {
-						if _, done := visited[g.indexToNodes[i]]; !done {
-							queue = append(queue, g.indexToNodes[i])
-							parents[g.indexToNodes[i]] = curr
-						}
-					}
+			}
+		}
+		for i := 0; i < len(g.indexToNodes); i++ {
+			if g.HasEdge(curr, g.indexToNodes[i]) {
+				if _, done := visited[g.indexToNodes[i]]; !done {
+					visited[g.indexToNodes[i]] = struct{}{}
+					queue = append(queue, g.indexToNodes[i])
+					parents[g.indexToNodes[i]] = curr
 				}
 			}
 		}
@@ -408,7 +441,11 @@ func (g *WeightedGraph[T]) BFSShortestPathAdjMatrix(source T, target T) []T {
 	return []T{}
 }
 
-func (g *WeightedGraph[T]) HasCycleDirectedAdjMatrix() bool {
+// HasCycleDirectedAdjMatrix reports whether following the edges of the
+// adjacency matrix leads back to a node.
+//
+// Deprecated: Use HasCycleDirected, which works for either representation.
+func (g *WeightedGraph[T, W]) HasCycleDirectedAdjMatrix() bool {
 	for i := 0; i < len(g.indexToNodes); i++ {
 		curr := g.indexToNodes[i]
 		visited := map[T]struct{}{}
@@ -442,7 +479,13 @@ func (g *WeightedGraph[T]) HasCycleDirectedAdjMatrix() bool {
 
 }
 
-func (g *WeightedGraph[T]) HasCycleUndirectedAdjMatrix() bool {
+// HasCycleUndirectedAdjMatrix reports whether a search of the adjacency
+// matrix reaches a visited node other than the one it came from. It always
+// reports false for a directed graph.
+//
+// Deprecated: Use HasCycleUndirected, which ignores edge directions and
+// counts every self-loop.
+func (g *WeightedGraph[T, W]) HasCycleUndirectedAdjMatrix() bool {
 	visited := map[T]struct{}{}
 	var dfs func(source T, parent T) bool
 	dfs = func(source T, parent T) bool {
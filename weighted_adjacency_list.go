@@ -1,41 +1,52 @@
 package graph
 
-func (g *WeightedGraph[T]) AddNodeAdjList(node T) {
+func (g *WeightedGraph[T, W]) AddNodeAdjList(node T) {
+	g.unshare()
 	if _, exists := g.nodes[node]; exists {
 		return
 	}
+	g.recordAddNode(node)
 	g.nodes[node] = struct{}{}
-	g.adjList[node] = make(map[T]int)
+	g.adjList[node] = make(map[T]W)
 }
 
-func (g *WeightedGraph[T]) RemoveNodeAdjList(node T) {
+func (g *WeightedGraph[T, W]) RemoveNodeAdjList(node T) {
+	g.unshare()
 	if _, exists := g.nodes[node]; !exists {
 		return
 	}
+	g.recordRemoveNode(node)
 	delete(g.nodes, node)
 	delete(g.adjList, node)
 	for key, _ := range g.nodes {
 		delete(g.adjList[key], node)
 	}
+	g.attrs.removeNode(node)
 }
 
-func (g *WeightedGraph[T]) AddEdgeAdjList(from T, to T, weight int) {
-	g.AddNode(from)
-	g.AddNode(to)
+func (g *WeightedGraph[T, W]) AddEdgeAdjList(from T, to T, weight W) {
+	g.unshare()
+	defer g.log.group()()
+	g.AddNodeAdjList(from)
+	g.AddNodeAdjList(to)
+	g.recordAddEdge(from, to, weight)
 	g.adjList[from][to] = weight
 	if g.graphType == Undirected {
 		g.adjList[to][from] = weight
 	}
 }
 
-func (g *WeightedGraph[T]) RemoveEdgeAdjList(from T, to T) {
+func (g *WeightedGraph[T, W]) RemoveEdgeAdjList(from T, to T) {
+	g.unshare()
+	g.recordRemoveEdge(from, to)
 	delete(g.adjList[from], to)
 	if g.graphType == Undirected {
 		delete(g.adjList[to], from)
 	}
+	g.attrs.removeEdge(from, to, g.IsDirected())
 }
 
-func (g *WeightedGraph[T]) HasEdgeAdjList(from T, to T) bool {
+func (g *WeightedGraph[T, W]) HasEdgeAdjList(from T, to T) bool {
 	possibleTo := g.adjList[from]
 	_, fte := possibleTo[to]
 
@@ -53,7 +64,14 @@ func (g *WeightedGraph[T]) HasEdgeAdjList(from T, to T) bool {
 	return false
 }
 
-func (g *WeightedGraph[T]) NeighboursAdjList(node T) []T {
+func (g *WeightedGraph[T, W]) WeightAdjList(from T, to T) (W, bool) {
+	if !g.HasEdgeAdjList(from, to) {
+		return Infinity[W](), false
+	}
+	return g.adjList[from][to], true
+}
+
+func (g *WeightedGraph[T, W]) NeighboursAdjList(node T) []T {
 	if !g.HasNode(node) {
 		return make([]T, 0)
 	}
@@ -68,13 +86,21 @@ func (g *WeightedGraph[T]) NeighboursAdjList(node T) []T {
 	}
 }
 
-type WeightedEdge[T comparable] struct {
+type WeightedEdge[T comparable, W Number] struct {
 	Edge   [2]T
-	Weight int
+	Weight W
 }
 
-func (g *WeightedGraph[T]) EdgesAdjList() []WeightedEdge[T] {
-	edges := make([]WeightedEdge[T], 0)
+// FlowEdge is an edge of a FlowNetwork carrying both a capacity and a
+// per-unit cost.
+type FlowEdge[T comparable, W Number] struct {
+	Edge     [2]T
+	Capacity W
+	Cost     W
+}
+
+func (g *WeightedGraph[T, W]) EdgesAdjList() []WeightedEdge[T, W] {
+	edges := make([]WeightedEdge[T, W], 0)
 	seen := make(map[[2]T]struct{})
 	for from, _ := range g.adjList {
 		for to, _ := range g.adjList[from] {
@@ -85,18 +111,18 @@ func (g *WeightedGraph[T]) EdgesAdjList() []WeightedEdge[T] {
 					continue
 				}
 			}
-			edges = append(edges, WeightedEdge[T]{Edge: elem, Weight: g.adjList[elem[0]][elem[1]]})
+			edges = append(edges, WeightedEdge[T, W]{Edge: elem, Weight: g.adjList[elem[0]][elem[1]]})
 			seen[elem] = struct{}{}
 		}
 	}
 	return edges
 }
 
-func (g *WeightedGraph[T]) OutDegreeAdjList(node T) int {
+func (g *WeightedGraph[T, W]) OutDegreeAdjList(node T) int {
 	return len(g.adjList[node])
 }
 
-func (g *WeightedGraph[T]) InDegreeAdjList(node T) int {
+func (g *WeightedGraph[T, W]) InDegreeAdjList(node T) int {
 	count := 0
 	for key, _ := range g.adjList {
 		if _, exists := g.adjList[key][node]; exists {
@@ -106,7 +132,7 @@ func (g *WeightedGraph[T]) InDegreeAdjList(node T) int {
 	return count
 }
 
-func (g *WeightedGraph[T]) BFSAdjList(start T) []T {
+func (g *WeightedGraph[T, W]) BFSAdjList(start T) []T {
 	order := []T{}
 	visited := make(map[T]struct{})
 	visited[start] = struct{}{}
@@ -125,7 +151,7 @@ func (g *WeightedGraph[T]) BFSAdjList(start T) []T {
 	return order
 }
 
-func (g *WeightedGraph[T]) DFSRecursiveAdjList(start T) []T {
+func (g *WeightedGraph[T, W]) DFSRecursiveAdjList(start T) []T {
 	order := []T{}
 	visited := make(map[T]struct{})
 	var dfs func(curr T)
@@ -142,7 +168,7 @@ func (g *WeightedGraph[T]) DFSRecursiveAdjList(start T) []T {
 	return order
 }
 
-func (g *WeightedGraph[T]) DFSIterativeAdjList(start T) []T {
+func (g *WeightedGraph[T, W]) DFSIterativeAdjList(start T) []T {
 	visited := make(map[T]struct{})
 	stack := []T{start}
 	order := []T{}
@@ -163,7 +189,7 @@ func (g *WeightedGraph[T]) DFSIterativeAdjList(start T) []T {
 	return order
 }
 
-func (g *WeightedGraph[T]) RecursiveDFSAllPathFindingAdjList(source T, target T) [][]T {
+func (g *WeightedGraph[T, W]) RecursiveDFSAllPathFindingAdjList(source T, target T) [][]T {
 	orders := [][]T{}
 	visited := make(map[T]struct{})
 
@@ -190,7 +216,7 @@ func (g *WeightedGraph[T]) RecursiveDFSAllPathFindingAdjList(source T, target T)
 	return orders
 }
 
-func (g *WeightedGraph[T]) RecursiveDFSAnyPathFindingAdjList(source T, target T) []T {
+func (g *WeightedGraph[T, W]) RecursiveDFSAnyPathFindingAdjList(source T, target T) []T {
 	order := []T{}
 	visited := make(map[T]struct{})
 
@@ -222,7 +248,7 @@ func (g *WeightedGraph[T]) RecursiveDFSAnyPathFindingAdjList(source T, target T)
 	return []T{}
 }
 
-func (g *WeightedGraph[T]) DFSIterativeAllPathFindingAdjList(source T, target T) [][]T {
+func (g *WeightedGraph[T, W]) DFSIterativeAllPathFindingAdjList(source T, target T) [][]T {
 	orders := [][]T{}
 	stack := []T{source}
 	visiteds := []map[T]struct{}{map[T]struct{}{source: {}}}
@@ -235,28 +261,26 @@ func (g *WeightedGraph[T]) DFSIterativeAllPathFindingAdjList(source T, target T)
 		visitingOrders = visitingOrders[:len(visitingOrders)-1]
 		currVisited := visiteds[len(visiteds)-1]
 		visiteds = visiteds[:len(visiteds)-1]
-		if _, ok := currVisited[curr]; !ok {
-			if curr == target {
-				temp := make([]T, len(currOrder))
-				copy(temp, currOrder)
-				orders = append(orders, temp)
-			} else {
-				for k := range g.adjList[curr] {
-					if _, done := currVisited[k]; !done {
-						stack = append(stack, k)
-						newVisited := map[T]struct{}{}
-						for k := range currVisited {
-							newVisited[k] = struct{}{}
-						}
+		if curr == target {
+			temp := make([]T, len(currOrder))
+			copy(temp, currOrder)
+			orders = append(orders, temp)
+		} else {
+			for k := range g.adjList[curr] {
+				if _, done := currVisited[k]; !done {
+					stack = append(stack, k)
+					newVisited := map[T]struct{}{}
+					for k := range currVisited {
 						newVisited[k] = struct{}{}
-						newOrder := make([]T, len(currOrder)+1)
-						copy(newOrder, currOrder)
-						newOrder[len(currOrder)] = k
-						visiteds = append(visiteds, newVisited)
-						visitingOrders = append(visitingOrders, newOrder)
 					}
-
+					newVisited[k] = struct{}{}
+					newOrder := make([]T, len(currOrder)+1)
+					copy(newOrder, currOrder)
+					newOrder[len(currOrder)] = k
+					visiteds = append(visiteds, newVisited)
+					visitingOrders = append(visitingOrders, newOrder)
 				}
+
 			}
 		}
 
@@ -265,7 +289,7 @@ func (g *WeightedGraph[T]) DFSIterativeAllPathFindingAdjList(source T, target T)
 
 }
 
-func (g *WeightedGraph[T]) DFSIterativeAnyPathFindingAdjList(source T, target T) []T {
+func (g *WeightedGraph[T, W]) DFSIterativeAnyPathFindingAdjList(source T, target T) []T {
 	stack := []T{source}
 	visiteds := []map[T]struct{}{{source: struct{}{}}}
 	visitingOrders := [][]T{[]T{source}}
@@ -276,11 +300,15 @@ func (g *WeightedGraph[T]) DFSIterativeAnyPathFindingAdjList(source T, target T)
 		currVisited := visiteds[len(visiteds)-1]
 		visiteds = visiteds[:len(visiteds)-1]
 		currVisitingOrder := visitingOrders[len(visitingOrders)-1]
+		visitingOrders = visitingOrders[:len(visitingOrders)-1]
 
 		if curr == target {
 			return currVisitingOrder
 		} else {
 			for k := range g.adjList[curr] {
+				if _, done := currVisited[k]; done {
+					continue
+				}
 				stack = append(stack, k)
 				newVisitingOrder := make([]T, len(currVisitingOrder)+1)
 				copy(newVisitingOrder, currVisitingOrder)
@@ -298,7 +326,7 @@ func (g *WeightedGraph[T]) DFSIterativeAnyPathFindingAdjList(source T, target T)
 	return order
 }
 
-func (g *WeightedGraph[T]) BFSShortestPathAdjList(source T, target T) []T {
+func (g *WeightedGraph[T, W]) BFSShortestPathAdjList(source T, target T) []T {
 	queue := []T{source}
 	visited := make(map[T]struct{})
 	parent := map[T]T{} //child -> Parent
@@ -328,7 +356,11 @@ func (g *WeightedGraph[T]) BFSShortestPathAdjList(source T, target T) []T {
 	return []T{}
 }
 
-func (g *WeightedGraph[T]) HasCycleDirectedAdjList() bool {
+// HasCycleDirectedAdjList reports whether following the edges of the
+// adjacency list leads back to a node.
+//
+// Deprecated: Use HasCycleDirected, which works for either representation.
+func (g *WeightedGraph[T, W]) HasCycleDirectedAdjList() bool {
 	visited := make(map[T]struct{})
 	recStack := make(map[T]struct{})
 	var dfs func(source T) bool
@@ -358,7 +390,14 @@ func (g *WeightedGraph[T]) HasCycleDirectedAdjList() bool {
 	return false
 }
 
-func (g *WeightedGraph[T]) HasCycleUndirectedAdjList() bool {
+// HasCycleUndirectedAdjList reports whether a search of the adjacency list
+// reaches a visited node other than the one it came from. A self-loop counts
+// unless it is on the node a search starts from, and edge directions are
+// followed as stored.
+//
+// Deprecated: Use HasCycleUndirected, which ignores edge directions and
+// counts every self-loop.
+func (g *WeightedGraph[T, W]) HasCycleUndirectedAdjList() bool {
 	visited := make(map[T]struct{})
 	var dfs func(source T, parent T) bool
 	dfs = func(source T, parent T) bool {